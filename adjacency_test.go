@@ -0,0 +1,68 @@
+package graph
+
+import (
+	"math/rand"
+	"strconv"
+)
+
+// adjacency is a directed graph used by the tests.
+// Vertices are listed in the order of the vertices slice.
+// Edge values are used as weights as well as capacities.
+type adjacency struct {
+	vertices []string
+	next     map[string][]string
+	weight   map[[2]string]float64
+	cost     map[[2]string]float64
+}
+
+func (g adjacency) Vertices() []string             { return g.vertices }
+func (g adjacency) NextVertices(v string) []string { return g.next[v] }
+func (g adjacency) Weight(v, w string) float64     { return g.weight[[2]string{v, w}] }
+func (g adjacency) Capacity(v, w string) float64   { return g.weight[[2]string{v, w}] }
+func (g adjacency) Cost(v, w string) float64       { return g.cost[[2]string{v, w}] }
+
+// randomAdjacency returns a random directed graph with n vertices named 0 to n-1.
+// Each edge exists with probability p and has an integer value in [0, maxValue).
+func randomAdjacency(rnd *rand.Rand, n int, p float64, maxValue int) adjacency {
+	g := adjacency{
+		next:   make(map[string][]string),
+		weight: make(map[[2]string]float64),
+	}
+	for i := 0; i < n; i++ {
+		g.vertices = append(g.vertices, strconv.Itoa(i))
+	}
+	for _, v := range g.vertices {
+		for _, w := range g.vertices {
+			if v != w && rnd.Float64() < p {
+				g.next[v] = append(g.next[v], w)
+				g.weight[[2]string{v, w}] = float64(rnd.Intn(maxValue))
+			}
+		}
+	}
+	return g
+}
+
+// randomConnectedUndirected returns a random connected undirected graph.
+func randomConnectedUndirected(rnd *rand.Rand, n int, p float64) adjacency {
+	g := randomAdjacency(rnd, n, p, 1)
+	for i := 1; i < n; i++ {
+		g.next[g.vertices[i-1]] = append(g.next[g.vertices[i-1]], g.vertices[i])
+	}
+	// list each edge in both directions, once
+	edges := make(map[[2]string]bool)
+	for v, next := range g.next {
+		for _, w := range next {
+			edges[[2]string{v, w}] = true
+			edges[[2]string{w, v}] = true
+		}
+	}
+	g.next = make(map[string][]string)
+	for _, v := range g.vertices {
+		for _, w := range g.vertices {
+			if edges[[2]string{v, w}] {
+				g.next[v] = append(g.next[v], w)
+			}
+		}
+	}
+	return g
+}
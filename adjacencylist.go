@@ -0,0 +1,50 @@
+package graph
+
+// adjacencyList is a directed graph storing the list of next vertices of each vertex.
+// It implements VertexListForward.
+type adjacencyList struct {
+	vertices []string
+	next     map[string][]string
+}
+
+func (g *adjacencyList) Vertices() []string             { return g.vertices }
+func (g *adjacencyList) NextVertices(v string) []string { return g.next[v] }
+
+// reverseGraph returns the graph g with all its edges reversed.
+// Edges leading to vertices which are not listed by g.Vertices are ignored.
+func reverseGraph(g VertexListForward) *adjacencyList {
+	r := &adjacencyList{
+		vertices: g.Vertices(),
+		next:     make(map[string][]string),
+	}
+	listed := make(map[string]bool, len(r.vertices))
+	for _, v := range r.vertices {
+		listed[v] = true
+	}
+	for _, v := range r.vertices {
+		for _, w := range g.NextVertices(v) {
+			if listed[w] {
+				r.next[w] = append(r.next[w], v)
+			}
+		}
+	}
+	return r
+}
+
+// indexSimpleAdjacency returns the vertices of g and the indices of the distinct neighbours of each vertex,
+// ignoring self-loops and edges leading to vertices which are not listed.
+func indexSimpleAdjacency(g VertexListForward) ([]string, [][]int) {
+	vertices, out := indexAdjacency(g)
+	seen := make([]int, len(vertices)) // last vertex having seen each vertex, plus one
+	for i, next := range out {
+		simple := next[:0]
+		for _, j := range next {
+			if j != i && seen[j] != i+1 {
+				seen[j] = i + 1
+				simple = append(simple, j)
+			}
+		}
+		out[i] = simple
+	}
+	return vertices, out
+}
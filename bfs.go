@@ -34,6 +34,35 @@ type BfsVisitor interface {
 	FinishVertex(v string)
 }
 
+// BfsEdgeVisitor is the visitor to be passed to BreadthFirstVisitEdges graph traversal function.
+// It is similar to BfsVisitor except that edge events receive the edge itself.
+type BfsEdgeVisitor interface {
+	// DiscoverVertex is called when a new vertex is found.
+	DiscoverVertex(v string)
+
+	// ExamineVertex is called when a vertex is dequeued.
+	ExamineVertex(v string)
+
+	// ExamineEdge is called when navigating through the edge.
+	ExamineEdge(e Edge)
+
+	// TreeEdge is called when navigating to a new vertex.
+	TreeEdge(e Edge)
+
+	// NonTreeEdge is called when navigating to an already discovered vertex.
+	NonTreeEdge(e Edge)
+
+	// GrayTarget is called when the vertex we are navigating to has already been discovered
+	// but has not been examined yet.
+	GrayTarget(e Edge)
+
+	// BlackTarget is called when the vertex we are navigating to has already been examined.
+	BlackTarget(e Edge)
+
+	// FinishVertex is called when a vertex has been examined.
+	FinishVertex(v string)
+}
+
 // BreadthFirstVisit visits a graph starting from the source vertex
 // and visiting closer vertices first.
 //
@@ -43,7 +72,7 @@ type BfsVisitor interface {
 // At some event points the visitor is called.
 // An appropriate visitor can then compute distances and shortest paths.
 func BreadthFirstVisit(g Forward, vis BfsVisitor, source string) {
-	breadthFirstSearch(g, vis, source, nil)
+	breadthFirstSearch(&forwardEdgeBuffer{g: g}, bfsEdgeAdapter{vis}, source, nil)
 }
 
 // BreadthFirstSearch visits a graph starting from the source vertex.
@@ -58,10 +87,40 @@ func BreadthFirstVisit(g Forward, vis BfsVisitor, source string) {
 // Methods TreeEdge(v, target) and DiscoverVertex(target) are called before the search stops.
 // If the target is not reachable from the source, it is equivalent to BreadthFisrtVisit.
 func BreadthFirstSearch(g Forward, vis BfsVisitor, source, target string) {
+	breadthFirstSearch(&forwardEdgeBuffer{g: g}, bfsEdgeAdapter{vis}, source, &target)
+}
+
+// BreadthFirstVisitEdges is similar to BreadthFirstVisit
+// except that it navigates through the edges of g
+// and that the visitor receives edges.
+//
+// The slice returned by calls to OutEdges is never modified.
+//
+// Parallel edges are all examined: the first one leading to a new vertex is a tree edge,
+// the other ones are non-tree edges.
+func BreadthFirstVisitEdges(g EdgeForward, vis BfsEdgeVisitor, source string) {
+	breadthFirstSearch(g, vis, source, nil)
+}
+
+// BreadthFirstSearchEdges is similar to BreadthFirstSearch
+// except that it navigates through the edges of g
+// and that the visitor receives edges.
+func BreadthFirstSearchEdges(g EdgeForward, vis BfsEdgeVisitor, source, target string) {
 	breadthFirstSearch(g, vis, source, &target)
 }
 
-func breadthFirstSearch(g Forward, vis BfsVisitor, source string, target *string) {
+// bfsEdgeAdapter turns a BfsVisitor into a BfsEdgeVisitor.
+type bfsEdgeAdapter struct {
+	BfsVisitor
+}
+
+func (vis bfsEdgeAdapter) ExamineEdge(e Edge) { vis.BfsVisitor.ExamineEdge(e.From, e.To) }
+func (vis bfsEdgeAdapter) TreeEdge(e Edge)    { vis.BfsVisitor.TreeEdge(e.From, e.To) }
+func (vis bfsEdgeAdapter) NonTreeEdge(e Edge) { vis.BfsVisitor.NonTreeEdge(e.From, e.To) }
+func (vis bfsEdgeAdapter) GrayTarget(e Edge)  { vis.BfsVisitor.GrayTarget(e.From, e.To) }
+func (vis bfsEdgeAdapter) BlackTarget(e Edge) { vis.BfsVisitor.BlackTarget(e.From, e.To) }
+
+func breadthFirstSearch(g EdgeForward, vis BfsEdgeVisitor, source string, target *string) {
 	// queue implemented with a list
 	queue := list.New()
 	// init color map
@@ -85,15 +144,16 @@ func breadthFirstSearch(g Forward, vis BfsVisitor, source string, target *string
 		vis.ExamineVertex(v)
 
 		// visit neighbours
-		for _, next := range g.NextVertices(v) {
+		for _, e := range g.OutEdges(v) {
 			// leave vertex v toward vertex next
 			// and examine the edge
-			vis.ExamineEdge(v, next)
+			next := e.To
+			vis.ExamineEdge(e)
 
 			// has next vertex already been discovered
 			if cmap[next] == white {
 				// vertex has not been discovered yet
-				vis.TreeEdge(v, next)
+				vis.TreeEdge(e)
 				vis.DiscoverVertex(next)
 				cmap[next] = gray    // mark as discovered
 				queue.PushBack(next) //enqueue
@@ -103,11 +163,11 @@ func breadthFirstSearch(g Forward, vis BfsVisitor, source string, target *string
 					return
 				}
 			} else {
-				vis.NonTreeEdge(v, next)
+				vis.NonTreeEdge(e)
 				if cmap[next] == gray {
-					vis.GrayTarget(v, next)
+					vis.GrayTarget(e)
 				} else {
-					vis.BlackTarget(v, next)
+					vis.BlackTarget(e)
 				}
 			}
 		}
@@ -0,0 +1,156 @@
+package graph
+
+// ArticulationPoints returns the cut vertices of an undirected graph,
+// i.e. the vertices whose removal increases the number of connected components.
+// Each edge must be listed in both directions.
+// Vertices are listed in the order of Vertices.
+//
+// It runs a low-link depth-first visit in linear time.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func ArticulationPoints(g VertexListForward) []string {
+	vis := newLowLinkVisitor()
+	DepthFirstVisit(g, vis)
+
+	var points []string
+	for _, v := range g.Vertices() {
+		if vis.articulation[v] {
+			points = append(points, v)
+		}
+	}
+	return points
+}
+
+// Bridges returns the bridges of an undirected graph,
+// i.e. the edges whose removal increases the number of connected components.
+// Each edge must be listed in both directions.
+// Parallel edges are never bridges.
+// Edges are oriented in the direction they have been visited and have no weight nor label.
+//
+// It runs a low-link depth-first visit in linear time.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func Bridges(g VertexListForward) []Edge {
+	vis := newLowLinkVisitor()
+	DepthFirstVisit(g, vis)
+	return vis.bridges
+}
+
+// BiconnectedComponents returns the biconnected components of an undirected graph.
+// They are a partition of the edges: two edges are in the same component
+// if and only if they belong to a common simple cycle.
+// Each edge must be listed in both directions but appears only once in the components.
+// Edges are oriented in the direction they have been visited and have no weight nor label.
+//
+// It runs a low-link depth-first visit in linear time.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func BiconnectedComponents(g VertexListForward) [][]Edge {
+	vis := newLowLinkVisitor()
+	DepthFirstVisit(g, vis)
+	return vis.components
+}
+
+// lowLinkVisitor is a DfsVisitor computing the articulation points, the bridges
+// and the biconnected components of an undirected graph.
+//
+// The low-link of a vertex is the lowest discovery time
+// reachable from its subtree through a single back edge.
+type lowLinkVisitor struct {
+	time     int               // current discovery time
+	disc     map[string]int    // discovery time of vertices
+	low      map[string]int    // low-link of vertices
+	parent   map[string]string // parent in the search tree
+	skipped  map[string]bool   // whether the edge back to the parent has been skipped
+	children map[string]int    // number of children in the search tree
+	stack    []Edge            // edges of the current components
+
+	articulation map[string]bool
+	bridges      []Edge
+	components   [][]Edge
+}
+
+func newLowLinkVisitor() *lowLinkVisitor {
+	return &lowLinkVisitor{
+		disc:         make(map[string]int),
+		low:          make(map[string]int),
+		parent:       make(map[string]string),
+		skipped:      make(map[string]bool),
+		children:     make(map[string]int),
+		articulation: make(map[string]bool),
+	}
+}
+
+func (vis *lowLinkVisitor) InitializeVertex(string)    {}
+func (vis *lowLinkVisitor) ExamineEdge(string, string) {}
+
+func (vis *lowLinkVisitor) DiscoverVertex(v string) {
+	vis.disc[v] = vis.time
+	vis.low[v] = vis.time
+	vis.time++
+}
+
+func (vis *lowLinkVisitor) TreeEdge(from, to string) {
+	vis.parent[to] = from
+	vis.children[from]++
+	vis.stack = append(vis.stack, Edge{From: from, To: to})
+}
+
+func (vis *lowLinkVisitor) BackEdge(from, to string) {
+	if from == to {
+		return // self-loops do not matter
+	}
+	if p, ok := vis.parent[from]; ok && p == to && !vis.skipped[from] {
+		// the tree edge seen from the other side
+		// a parallel edge is a genuine back edge though
+		vis.skipped[from] = true
+		return
+	}
+
+	if vis.disc[to] < vis.low[from] {
+		vis.low[from] = vis.disc[to]
+	}
+	vis.stack = append(vis.stack, Edge{From: from, To: to})
+}
+
+// ForwardCrossEdge is called for back edges seen from the ancestor side:
+// they have already been taken into account.
+func (vis *lowLinkVisitor) ForwardCrossEdge(string, string) {}
+
+func (vis *lowLinkVisitor) FinishVertex(v string) {
+	p, ok := vis.parent[v]
+	if !ok {
+		// roots are articulation points if they have several children
+		if vis.children[v] > 1 {
+			vis.articulation[v] = true
+		}
+		return
+	}
+
+	if vis.low[v] < vis.low[p] {
+		vis.low[p] = vis.low[v]
+	}
+	if vis.low[v] > vis.disc[p] {
+		vis.bridges = append(vis.bridges, Edge{From: p, To: v})
+	}
+	if vis.low[v] >= vis.disc[p] {
+		// the subtree of v cannot go above p:
+		// p separates it from the rest of the graph
+		if _, notRoot := vis.parent[p]; notRoot {
+			vis.articulation[p] = true
+		}
+
+		// pop the component up to the tree edge p-v
+		i := len(vis.stack) - 1
+		for vis.stack[i] != (Edge{From: p, To: v}) {
+			i--
+		}
+		component := make([]Edge, len(vis.stack)-i)
+		copy(component, vis.stack[i:])
+		vis.components = append(vis.components, component)
+		vis.stack = vis.stack[:i]
+	}
+}
@@ -0,0 +1,213 @@
+package graph
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// countComponents counts the connected components of an undirected graph
+// without the removed vertex and the removed edge.
+func countComponents(g adjacency, removedVertex string, removedEdge [2]string) int {
+	seen := map[string]bool{removedVertex: true}
+	count := 0
+	for _, root := range g.vertices {
+		if seen[root] {
+			continue
+		}
+		count++
+		seen[root] = true
+		stack := []string{root}
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, w := range g.next[v] {
+				if [2]string{v, w} == removedEdge || [2]string{w, v} == removedEdge {
+					continue
+				}
+				if !seen[w] {
+					seen[w] = true
+					stack = append(stack, w)
+				}
+			}
+		}
+	}
+	return count
+}
+
+// edgeKey returns the key of the undirected edge v-w.
+func edgeKey(v, w string) [2]string {
+	if w < v {
+		return [2]string{w, v}
+	}
+	return [2]string{v, w}
+}
+
+// commonCycles returns the pairs of undirected edges lying on a common simple cycle.
+// Cycles made of parallel edges only are ignored: they contain a single pair of vertices.
+func commonCycles(g adjacency) map[[2][2]string]bool {
+	index := make(map[string]int)
+	for i, v := range g.vertices {
+		index[v] = i
+	}
+	pairs := make(map[[2][2]string]bool)
+
+	// cycles are walked from their first vertex in g.vertices
+	var s string
+	path := []string{}
+	onPath := make(map[string]bool)
+	var walk func(v string)
+	walk = func(v string) {
+		done := make(map[string]bool) // parallel edges lead to the same cycles
+		for _, w := range g.next[v] {
+			if done[w] {
+				continue
+			}
+			done[w] = true
+			if w == s && len(path) >= 3 {
+				cycle := append(path, s)
+				for i := 1; i < len(cycle); i++ {
+					for j := 1; j < len(cycle); j++ {
+						pairs[[2][2]string{edgeKey(cycle[i-1], cycle[i]), edgeKey(cycle[j-1], cycle[j])}] = true
+					}
+				}
+			}
+			if index[w] > index[s] && !onPath[w] {
+				path = append(path, w)
+				onPath[w] = true
+				walk(w)
+				onPath[w] = false
+				path = path[:len(path)-1]
+			}
+		}
+	}
+	for _, v := range g.vertices {
+		s = v
+		path = append(path[:0], v)
+		walk(v)
+	}
+	return pairs
+}
+
+// TestLowLink compares articulation points, bridges and biconnected components
+// of random undirected graphs, possibly with parallel edges, with a brute force search.
+func TestLowLink(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 300; trial++ {
+		n := 1 + rnd.Intn(10)
+		g := randomAdjacency(rnd, n, 0.15, 1)
+		// make the graph undirected, without parallel edges
+		for _, v := range g.vertices {
+			for _, w := range g.next[v] {
+				if _, ok := g.weight[[2]string{w, v}]; !ok {
+					g.weight[[2]string{w, v}] = 0
+					g.next[w] = append(g.next[w], v)
+				}
+			}
+		}
+		// then duplicate some edges
+		multiplicity := make(map[[2]string]int)
+		var parallel [][2]string
+		for _, v := range g.vertices {
+			for _, w := range g.next[v] {
+				if v < w {
+					multiplicity[[2]string{v, w}] = 1
+					if rnd.Intn(5) == 0 {
+						parallel = append(parallel, [2]string{v, w})
+					}
+				}
+			}
+		}
+		for _, e := range parallel {
+			g.next[e[0]] = append(g.next[e[0]], e[1])
+			g.next[e[1]] = append(g.next[e[1]], e[0])
+			multiplicity[e]++
+		}
+		before := countComponents(g, "", [2]string{})
+
+		// articulation points
+		isPoint := make(map[string]bool)
+		for _, v := range ArticulationPoints(g) {
+			isPoint[v] = true
+		}
+		for _, v := range g.vertices {
+			// removing an isolated vertex decreases the number of components
+			expected := countComponents(g, v, [2]string{}) > before
+			if isPoint[v] != expected {
+				t.Errorf("trial %d: %s articulation point: %v instead of %v", trial, v, isPoint[v], expected)
+			}
+		}
+
+		// bridges
+		isBridge := make(map[[2]string]bool)
+		for _, e := range Bridges(g) {
+			isBridge[edgeKey(e.From, e.To)] = true
+		}
+		for e, m := range multiplicity {
+			// countComponents removes all the parallel edges
+			expected := m == 1 && countComponents(g, "", e) > before
+			if isBridge[e] != expected {
+				t.Errorf("trial %d: %v bridge: %v instead of %v", trial, e, isBridge[e], expected)
+			}
+		}
+
+		// biconnected components
+		component := make(map[[2]string]int)
+		count := make(map[[2]string]int)
+		for c, edges := range BiconnectedComponents(g) {
+			for _, e := range edges {
+				key := edgeKey(e.From, e.To)
+				if d, found := component[key]; found && d != c {
+					t.Errorf("trial %d: edge %v in components %d and %d", trial, e, d, c)
+				}
+				component[key] = c
+				count[key]++
+			}
+			if len(edges) == 1 && !isBridge[edgeKey(edges[0].From, edges[0].To)] {
+				t.Errorf("trial %d: single edge component %v is not a bridge", trial, edges)
+			}
+		}
+		if !reflect.DeepEqual(count, multiplicity) {
+			t.Errorf("trial %d: edges %v in components instead of %v", trial, count, multiplicity)
+		}
+		cycles := commonCycles(g)
+		for e := range multiplicity {
+			for f := range multiplicity {
+				if e == f {
+					continue
+				}
+				same := component[e] == component[f]
+				if expected := cycles[[2][2]string{e, f}]; same != expected {
+					t.Errorf("trial %d: %v and %v in the same component: %v instead of %v", trial, e, f, same, expected)
+				}
+			}
+		}
+	}
+}
+
+// TestLowLinkParallelEdges checks that parallel edges are never bridges
+// and form a biconnected component.
+func TestLowLinkParallelEdges(t *testing.T) {
+	g := adjacency{
+		vertices: []string{"a", "b", "c"},
+		next: map[string][]string{
+			"a": {"b", "b"},
+			"b": {"a", "a", "c"},
+			"c": {"b"},
+		},
+	}
+
+	if points := ArticulationPoints(g); !reflect.DeepEqual(points, []string{"b"}) {
+		t.Errorf("articulation points: %v instead of [b]", points)
+	}
+	if bridges := Bridges(g); !reflect.DeepEqual(bridges, []Edge{{From: "b", To: "c"}}) {
+		t.Errorf("bridges: %v instead of [b-c]", bridges)
+	}
+	expected := [][]Edge{
+		{{From: "b", To: "c"}},
+		{{From: "a", To: "b"}, {From: "b", To: "a"}},
+	}
+	if components := BiconnectedComponents(g); !reflect.DeepEqual(components, expected) {
+		t.Errorf("biconnected components: %v instead of %v", components, expected)
+	}
+}
@@ -0,0 +1,94 @@
+package graph
+
+// IsBipartite checks whether an undirected graph is bipartite,
+// i.e. whether its vertices can be colored with two colors
+// so that no edge links two vertices of the same color.
+// Each edge must be listed in both directions.
+//
+// If g is bipartite, it returns the color, 0 or 1, of each vertex.
+// Otherwise, it returns an odd cycle as evidence:
+// the list of its vertices, the last one being linked to the first one.
+//
+// It runs a breadth-first visit from each vertex not visited yet, in linear time.
+// Two vertices of the same color linked by a non-tree edge reveal an odd cycle.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func IsBipartite(g VertexListForward) (bipartite bool, coloring map[string]int, oddCycle []string) {
+	vis := &bipartiteVisitor{
+		color: make(map[string]int),
+		depth: make(map[string]int),
+		pred:  make(map[string]string),
+	}
+	for _, v := range g.Vertices() {
+		if _, visited := vis.color[v]; !visited {
+			BreadthFirstVisit(g, vis, v)
+		}
+		if vis.conflict {
+			return false, nil, vis.oddCycle()
+		}
+	}
+	return true, vis.color, nil
+}
+
+// bipartiteVisitor is a BfsVisitor coloring vertices with alternating colors
+// along the breadth-first search tree.
+// It records the first non-tree edge between vertices of the same color.
+type bipartiteVisitor struct {
+	color map[string]int    // color of discovered vertices
+	depth map[string]int    // depth in the search tree
+	pred  map[string]string // parent in the search tree
+
+	conflict bool   // whether an edge between vertices of the same color has been found
+	from, to string // this edge
+}
+
+func (vis *bipartiteVisitor) ExamineVertex(string)       {}
+func (vis *bipartiteVisitor) ExamineEdge(string, string) {}
+func (vis *bipartiteVisitor) GrayTarget(string, string)  {}
+func (vis *bipartiteVisitor) BlackTarget(string, string) {}
+func (vis *bipartiteVisitor) FinishVertex(string)        {}
+
+func (vis *bipartiteVisitor) DiscoverVertex(v string) {
+	if _, colored := vis.color[v]; !colored {
+		// root of a new search tree
+		vis.color[v] = 0
+		vis.depth[v] = 0
+	}
+}
+
+func (vis *bipartiteVisitor) TreeEdge(from, to string) {
+	vis.color[to] = 1 - vis.color[from]
+	vis.depth[to] = vis.depth[from] + 1
+	vis.pred[to] = from
+}
+
+func (vis *bipartiteVisitor) NonTreeEdge(from, to string) {
+	if !vis.conflict && vis.color[from] == vis.color[to] {
+		vis.conflict = true
+		vis.from, vis.to = from, to
+	}
+}
+
+// oddCycle returns the cycle made of the conflicting edge
+// and the tree paths from its vertices to their lowest common ancestor.
+func (vis *bipartiteVisitor) oddCycle() []string {
+	u, v := vis.from, vis.to
+	var up, down []string // paths from u and v to the ancestor
+	for u != v {
+		if vis.depth[u] >= vis.depth[v] {
+			up = append(up, u)
+			u = vis.pred[u]
+		} else {
+			down = append(down, v)
+			v = vis.pred[v]
+		}
+	}
+
+	// go up from u to the ancestor and down to v
+	cycle := append(up, u)
+	for i := len(down) - 1; i >= 0; i-- {
+		cycle = append(cycle, down[i])
+	}
+	return cycle
+}
@@ -0,0 +1,48 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// TestIsBipartite checks on random undirected graphs
+// that either the coloring is valid or the cycle is an odd cycle of the graph.
+func TestIsBipartite(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 300; trial++ {
+		g := randomAdjacency(rnd, 1+rnd.Intn(10), 0.2, 1)
+		// make the graph undirected
+		for _, v := range g.vertices {
+			for _, w := range g.next[v] {
+				if _, ok := g.weight[[2]string{w, v}]; !ok {
+					g.weight[[2]string{w, v}] = 0
+					g.next[w] = append(g.next[w], v)
+				}
+			}
+		}
+
+		bipartite, coloring, cycle := IsBipartite(g)
+		if bipartite {
+			for e := range g.weight {
+				if coloring[e[0]] == coloring[e[1]] {
+					t.Errorf("trial %d: %s and %s have the same color", trial, e[0], e[1])
+				}
+			}
+			continue
+		}
+
+		if len(cycle)%2 == 0 {
+			t.Errorf("trial %d: cycle %v is even", trial, cycle)
+		}
+		seen := make(map[string]bool)
+		for i, v := range cycle {
+			if seen[v] {
+				t.Errorf("trial %d: cycle %v is not simple", trial, cycle)
+			}
+			seen[v] = true
+			if _, ok := g.weight[[2]string{v, cycle[(i+1)%len(cycle)]}]; !ok {
+				t.Errorf("trial %d: cycle %v is not a cycle of the graph", trial, cycle)
+			}
+		}
+	}
+}
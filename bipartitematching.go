@@ -0,0 +1,206 @@
+package graph
+
+import "math"
+
+// HopcroftKarp computes a maximum matching of a bipartite graph.
+// left is the list of vertices of one side of the graph:
+// NextVertices must return vertices of the other side for these vertices.
+// NextVertices is not called on the other side, so g can be directed from left to right.
+//
+// It returns the matching, mapping each matched left vertex to its mate,
+// and a minimum vertex cover, i.e. a minimum set of vertices touching all edges.
+// By Kőnig's theorem, the cover has as many vertices as the matching has edges.
+// It is derived from a depth-first visit of the alternating graph
+// started from unmatched left vertices.
+//
+// It runs in O(E sqrt(V)) time.
+//
+// The slice returned by calls to NextVertices is never modified.
+// So there is no risk of accidentally modifying g.
+func HopcroftKarp(g Forward, left []string) (matching map[string]string, cover []string) {
+	// index vertices: left vertices first and then right vertices
+	index := make(map[string]int)
+	var right []string
+	for _, u := range left {
+		index[u] = len(index)
+	}
+	adj := make([][]int, len(left))
+	for u, v := range left {
+		for _, w := range g.NextVertices(v) {
+			r, found := index[w]
+			if !found {
+				r = len(left) + len(right)
+				index[w] = r
+				right = append(right, w)
+			}
+			adj[u] = append(adj[u], r-len(left))
+		}
+	}
+
+	hk := &hopcroftKarp{
+		adj:    adj,
+		matchL: make([]int, len(left)),
+		matchR: make([]int, len(right)),
+		dist:   make([]int, len(left)),
+		next:   make([]int, len(left)),
+	}
+	for u := range hk.matchL {
+		hk.matchL[u] = -1
+	}
+	for r := range hk.matchR {
+		hk.matchR[r] = -1
+	}
+
+	// augment the matching along a maximal set of shortest augmenting paths
+	for hk.layer() {
+		for u := range hk.next {
+			hk.next[u] = 0
+		}
+		for u, r := range hk.matchL {
+			if r < 0 {
+				hk.augment(u)
+			}
+		}
+	}
+
+	// matching with names
+	matching = make(map[string]string)
+	for u, r := range hk.matchL {
+		if r >= 0 {
+			matching[left[u]] = right[r]
+		}
+	}
+
+	// vertices reachable from unmatched left vertices through alternating paths
+	alt := alternatingGraph{
+		g:    g,
+		left: make(map[string]bool, len(left)),
+		mate: make(map[string]string, 2*len(matching)),
+	}
+	for _, u := range left {
+		alt.left[u] = true
+	}
+	for u, r := range matching {
+		alt.mate[u] = r
+		alt.mate[r] = u
+	}
+	vis := &discoveryVisitor{discovered: make(map[string]bool)}
+	walk := &dfsEdgeAdapter{DfsVisitor: vis, g: alt}
+	cmap := make(map[string]color)
+	for _, u := range left {
+		if _, matched := matching[u]; !matched && cmap[u] == white {
+			depthFirstVisitFrom(walk, walk, cmap, u)
+		}
+	}
+
+	// cover is made of unreachable left vertices and reachable right vertices
+	for _, u := range left {
+		if !vis.discovered[u] {
+			cover = append(cover, u)
+		}
+	}
+	for _, r := range right {
+		if vis.discovered[r] {
+			cover = append(cover, r)
+		}
+	}
+
+	return matching, cover
+}
+
+// hopcroftKarp holds the state of the Hopcroft-Karp algorithm.
+// Left and right vertices are indexed separately.
+type hopcroftKarp struct {
+	adj    [][]int // right neighbours of left vertices
+	matchL []int   // mate of left vertices, -1 if unmatched
+	matchR []int   // mate of right vertices, -1 if unmatched
+	dist   []int   // layer of left vertices
+	next   []int   // next neighbour to examine for each left vertex
+}
+
+// layer computes the layers of left vertices with a breadth-first search
+// from unmatched left vertices.
+// It returns true if there is an augmenting path.
+func (hk *hopcroftKarp) layer() bool {
+	var queue []int
+	for u, r := range hk.matchL {
+		if r < 0 {
+			hk.dist[u] = 0
+			queue = append(queue, u)
+		} else {
+			hk.dist[u] = math.MaxInt32
+		}
+	}
+
+	found := false
+	for i := 0; i < len(queue); i++ {
+		u := queue[i]
+		for _, r := range hk.adj[u] {
+			mate := hk.matchR[r]
+			if mate < 0 {
+				found = true
+			} else if hk.dist[mate] == math.MaxInt32 {
+				hk.dist[mate] = hk.dist[u] + 1
+				queue = append(queue, mate)
+			}
+		}
+	}
+	return found
+}
+
+// augment looks for an augmenting path from left vertex u following the layers.
+// It returns true if the matching has been augmented.
+func (hk *hopcroftKarp) augment(u int) bool {
+	for ; hk.next[u] < len(hk.adj[u]); hk.next[u]++ {
+		r := hk.adj[u][hk.next[u]]
+		mate := hk.matchR[r]
+		if mate < 0 || (hk.dist[mate] == hk.dist[u]+1 && hk.augment(mate)) {
+			hk.matchL[u] = r
+			hk.matchR[r] = u
+			return true
+		}
+	}
+
+	// dead end: remove u from the layers
+	hk.dist[u] = math.MaxInt32
+	return false
+}
+
+// alternatingGraph is the Forward graph of alternating paths of a bipartite graph:
+// left vertices lead to their non-matched neighbours
+// and right vertices lead to their mate.
+type alternatingGraph struct {
+	g    Forward
+	left map[string]bool   // left vertices
+	mate map[string]string // mate of matched vertices
+}
+
+func (alt alternatingGraph) NextVertices(v string) []string {
+	if !alt.left[v] {
+		if u, matched := alt.mate[v]; matched {
+			return []string{u}
+		}
+		return nil
+	}
+
+	var next []string
+	for _, w := range alt.g.NextVertices(v) {
+		if w != alt.mate[v] {
+			next = append(next, w)
+		}
+	}
+	return next
+}
+
+// discoveryVisitor is a DfsVisitor recording discovered vertices.
+type discoveryVisitor struct {
+	discovered map[string]bool
+}
+
+func (vis *discoveryVisitor) InitializeVertex(string)         {}
+func (vis *discoveryVisitor) DiscoverVertex(v string)         { vis.discovered[v] = true }
+func (vis *discoveryVisitor) ExamineEdge(string, string)      {}
+func (vis *discoveryVisitor) TreeEdge(string, string)         {}
+func (vis *discoveryVisitor) BackEdge(string, string)         {}
+func (vis *discoveryVisitor) ForwardCrossEdge(string, string) {}
+func (vis *discoveryVisitor) FinishVertex(string)             {}
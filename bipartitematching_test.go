@@ -0,0 +1,63 @@
+package graph
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+// TestHopcroftKarp checks on random bipartite graphs that the matching is valid,
+// that the cover covers all edges and that both have the same size,
+// which proves they are optimal.
+func TestHopcroftKarp(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 200; trial++ {
+		nl, nr := rnd.Intn(10), 1+rnd.Intn(10)
+		g := adjacency{next: make(map[string][]string)}
+		var left []string
+		for i := 0; i < nl; i++ {
+			u := "l" + strconv.Itoa(i)
+			left = append(left, u)
+			for j := 0; j < nr; j++ {
+				if rnd.Intn(4) == 0 {
+					g.next[u] = append(g.next[u], "r"+strconv.Itoa(j))
+				}
+			}
+		}
+
+		matching, cover := HopcroftKarp(g, left)
+
+		// matching uses edges of the graph and right vertices at most once
+		matched := make(map[string]bool)
+		for u, r := range matching {
+			isEdge := false
+			for _, w := range g.NextVertices(u) {
+				isEdge = isEdge || w == r
+			}
+			if !isEdge {
+				t.Errorf("trial %d: %s-%s is not an edge", trial, u, r)
+			}
+			if matched[r] {
+				t.Errorf("trial %d: %s is matched twice", trial, r)
+			}
+			matched[r] = true
+		}
+
+		// cover touches all edges
+		covered := make(map[string]bool)
+		for _, v := range cover {
+			covered[v] = true
+		}
+		for _, u := range left {
+			for _, r := range g.NextVertices(u) {
+				if !covered[u] && !covered[r] {
+					t.Errorf("trial %d: edge %s-%s is not covered", trial, u, r)
+				}
+			}
+		}
+
+		if len(cover) != len(matching) {
+			t.Errorf("trial %d: cover has %d vertices and matching has %d edges", trial, len(cover), len(matching))
+		}
+	}
+}
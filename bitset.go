@@ -0,0 +1,76 @@
+package graph
+
+import "math/bits"
+
+// bitset is a set of small non-negative integers.
+type bitset []uint64
+
+func newBitset(n int) bitset { return make(bitset, (n+63)/64) }
+
+// add adds i to the set.
+func (s bitset) add(i int) { s[i/64] |= 1 << uint(i%64) }
+
+// has returns true if i is in the set.
+func (s bitset) has(i int) bool { return s[i/64]&(1<<uint(i%64)) != 0 }
+
+// union adds the elements of t to the set.
+// Both sets must have the same capacity.
+func (s bitset) union(t bitset) {
+	for i := range s {
+		s[i] |= t[i]
+	}
+}
+
+// remove removes i from the set.
+func (s bitset) remove(i int) { s[i/64] &^= 1 << uint(i%64) }
+
+// intersection returns a new set with the elements both in s and t.
+// Both sets must have the same capacity.
+func (s bitset) intersection(t bitset) bitset {
+	r := make(bitset, len(s))
+	for i := range s {
+		r[i] = s[i] & t[i]
+	}
+	return r
+}
+
+// difference returns a new set with the elements in s but not in t.
+// Both sets must have the same capacity.
+func (s bitset) difference(t bitset) bitset {
+	r := make(bitset, len(s))
+	for i := range s {
+		r[i] = s[i] &^ t[i]
+	}
+	return r
+}
+
+// empty returns true if the set has no element.
+func (s bitset) empty() bool {
+	for _, word := range s {
+		if word != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// count returns the number of elements of the set.
+func (s bitset) count() int {
+	n := 0
+	for _, word := range s {
+		n += bits.OnesCount64(word)
+	}
+	return n
+}
+
+// elements returns the elements of the set in increasing order.
+func (s bitset) elements() []int {
+	var elements []int
+	for i, word := range s {
+		for word != 0 {
+			elements = append(elements, i*64+bits.TrailingZeros64(word))
+			word &= word - 1
+		}
+	}
+	return elements
+}
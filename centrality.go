@@ -0,0 +1,243 @@
+package graph
+
+import "sync"
+
+// PathCentralityOptions configures centralities based on shortest paths.
+type PathCentralityOptions struct {
+	// Normalized scales the scores to make them comparable across graphs of different sizes.
+	Normalized bool
+
+	// Workers is the number of goroutines sharing the sources of the shortest paths.
+	// It defaults to one.
+	// With several workers, g must be safe for concurrent use.
+	Workers int
+}
+
+// Betweenness computes the betweenness centrality of the vertices of an unweighted graph:
+// the sum over all pairs of vertices s, t of the fraction of shortest paths from s to t
+// going through the vertex, s and t excluded.
+// When normalized, scores are divided by (n-1)(n-2), the number of pairs of other vertices.
+//
+// On an undirected graph, each edge being listed in both directions,
+// every pair is counted twice: divide the scores by two to count it once.
+//
+// It is Brandes' algorithm: a breadth-first search from each source counts shortest paths
+// and dependencies are accumulated in reverse order of distance.
+// It runs in O(VE) time.
+// Edges leading to vertices which are not listed by Vertices are ignored.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func Betweenness(g VertexListForward, opts PathCentralityOptions) map[string]float64 {
+	betweenness, _, _ := pathCentrality(g, ForwardEdges(g), false, opts)
+	return betweenness
+}
+
+// WeightedBetweenness is similar to Betweenness except that shortest paths minimise the sum of positive weights.
+// Shortest paths are computed with Dijkstra's algorithm, in O(VE + V^2 log V) time.
+func WeightedBetweenness(g VertexListWeightForward, opts PathCentralityOptions) map[string]float64 {
+	betweenness, _, _ := pathCentrality(g, WeightForwardEdges(g), true, opts)
+	return betweenness
+}
+
+// Closeness computes the closeness centrality of the vertices of an unweighted graph:
+// the inverse of the average distance from the vertex to the vertices it reaches.
+// Vertices reaching no other vertex have a zero score.
+// When normalized, the score is multiplied by the fraction of other vertices it reaches (Wasserman and Faust),
+// so that vertices reaching few vertices are not favoured.
+//
+// It runs a breadth-first search from each vertex.
+// Edges leading to vertices which are not listed by Vertices are ignored.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func Closeness(g VertexListForward, opts PathCentralityOptions) map[string]float64 {
+	_, closeness, _ := pathCentrality(g, ForwardEdges(g), false, opts)
+	return closeness
+}
+
+// WeightedCloseness is similar to Closeness except that distances are sums of positive weights.
+func WeightedCloseness(g VertexListWeightForward, opts PathCentralityOptions) map[string]float64 {
+	_, closeness, _ := pathCentrality(g, WeightForwardEdges(g), true, opts)
+	return closeness
+}
+
+// Harmonic computes the harmonic centrality of the vertices of an unweighted graph:
+// the sum of the inverse distances from the vertex to the other vertices,
+// unreachable vertices contributing zero.
+// When normalized, scores are divided by n-1.
+//
+// It runs a breadth-first search from each vertex.
+// Edges leading to vertices which are not listed by Vertices are ignored.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func Harmonic(g VertexListForward, opts PathCentralityOptions) map[string]float64 {
+	_, _, harmonic := pathCentrality(g, ForwardEdges(g), false, opts)
+	return harmonic
+}
+
+// WeightedHarmonic is similar to Harmonic except that distances are sums of positive weights.
+func WeightedHarmonic(g VertexListWeightForward, opts PathCentralityOptions) map[string]float64 {
+	_, _, harmonic := pathCentrality(g, WeightForwardEdges(g), true, opts)
+	return harmonic
+}
+
+// shortestPathCounter counts the shortest paths from a source for Brandes' algorithm.
+// It is both a BfsEdgeVisitor and a DijkstraEdgeVisitor.
+type shortestPathCounter struct {
+	order []string            // reached vertices by non-decreasing distance
+	dist  map[string]float64  // distance from the source
+	sigma map[string]float64  // number of shortest paths from the source
+	pred  map[string][]string // predecessors on shortest paths
+}
+
+// count counts the shortest paths from the source,
+// using Dijkstra's algorithm if weighted and a breadth-first search otherwise.
+func (c *shortestPathCounter) count(g EdgeForward, weighted bool, source string) {
+	c.order = c.order[:0]
+	c.dist = map[string]float64{source: 0}
+	c.sigma = map[string]float64{source: 1}
+	c.pred = make(map[string][]string)
+	if weighted {
+		dijkstra(g, edgeWeight, c, source, nil)
+	} else {
+		breadthFirstSearch(g, c, source, nil)
+	}
+}
+
+func (c *shortestPathCounter) DiscoverVertex(string) {}
+func (c *shortestPathCounter) ExamineEdge(Edge)      {}
+func (c *shortestPathCounter) GrayTarget(Edge)       {}
+func (c *shortestPathCounter) BlackTarget(Edge)      {}
+func (c *shortestPathCounter) FinishVertex(string)   {}
+
+func (c *shortestPathCounter) ExamineVertex(v string) { c.order = append(c.order, v) }
+
+// TreeEdge and EdgeRelaxed record a shorter path to e.To.
+func (c *shortestPathCounter) TreeEdge(e Edge) { c.EdgeRelaxed(e) }
+
+func (c *shortestPathCounter) EdgeRelaxed(e Edge) {
+	c.dist[e.To] = c.dist[e.From] + e.Weight
+	c.sigma[e.To] = c.sigma[e.From]
+	c.pred[e.To] = append(c.pred[e.To][:0], e.From)
+}
+
+// NonTreeEdge and EdgeNotRelaxed record another shortest path to e.To, if any.
+func (c *shortestPathCounter) NonTreeEdge(e Edge) { c.EdgeNotRelaxed(e) }
+
+func (c *shortestPathCounter) EdgeNotRelaxed(e Edge) {
+	if c.dist[e.To] == c.dist[e.From]+e.Weight {
+		c.sigma[e.To] += c.sigma[e.From]
+		c.pred[e.To] = append(c.pred[e.To], e.From)
+	}
+}
+
+// listedEdges is an EdgeForward graph hiding the edges leading to vertices which are not listed.
+type listedEdges struct {
+	g      EdgeForward
+	listed map[string]bool
+}
+
+func (l listedEdges) OutEdges(v string) []Edge {
+	var edges []Edge
+	for _, e := range l.g.OutEdges(v) {
+		if l.listed[e.To] {
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}
+
+// pathCentrality computes betweenness, closeness and harmonic centralities
+// from shortest paths computations from every vertex.
+func pathCentrality(g VertexListForward, edges EdgeForward, weighted bool, opts PathCentralityOptions) (betweenness, closeness, harmonic map[string]float64) {
+	vertices := g.Vertices()
+	n := len(vertices)
+	listed := make(map[string]bool, n)
+	for _, v := range vertices {
+		listed[v] = true
+	}
+	betweenness = make(map[string]float64, n)
+	closeness = make(map[string]float64, n)
+	harmonic = make(map[string]float64, n)
+	for _, v := range vertices {
+		betweenness[v] = 0
+	}
+	edges = listedEdges{g: edges, listed: listed}
+
+	workers := opts.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	sources := make(chan string)
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for k := 0; k < workers; k++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c := &shortestPathCounter{}
+			local := make(map[string]float64)
+			delta := make(map[string]float64)
+			for s := range sources {
+				c.count(edges, weighted, s)
+
+				// dependencies in reverse order of distance
+				for _, w := range c.order {
+					delta[w] = 0
+				}
+				for i := len(c.order) - 1; i > 0; i-- {
+					w := c.order[i]
+					for _, v := range c.pred[w] {
+						delta[v] += c.sigma[v] / c.sigma[w] * (1 + delta[w])
+					}
+					local[w] += delta[w]
+				}
+
+				// distances to reached vertices
+				sum, inverse := 0.0, 0.0
+				for _, w := range c.order[1:] {
+					sum += c.dist[w]
+					if c.dist[w] > 0 {
+						inverse += 1 / c.dist[w]
+					}
+				}
+				reached := float64(len(c.order) - 1)
+				score := 0.0
+				if sum > 0 {
+					score = reached / sum
+					if opts.Normalized {
+						score *= reached / float64(n-1)
+					}
+				}
+				if opts.Normalized && n > 1 {
+					inverse /= float64(n - 1)
+				}
+
+				lock.Lock()
+				closeness[s] = score
+				harmonic[s] = inverse
+				lock.Unlock()
+			}
+
+			lock.Lock()
+			for v, b := range local {
+				betweenness[v] += b
+			}
+			lock.Unlock()
+		}()
+	}
+	for _, v := range vertices {
+		sources <- v
+	}
+	close(sources)
+	wg.Wait()
+
+	if opts.Normalized && n > 2 {
+		for v := range betweenness {
+			betweenness[v] /= float64((n - 1) * (n - 2))
+		}
+	}
+	return betweenness, closeness, harmonic
+}
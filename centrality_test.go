@@ -0,0 +1,129 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// TestPathCentrality compares betweenness, closeness and harmonic centralities of random graphs
+// with a brute force computation from all pairs distances and shortest path counts.
+func TestPathCentrality(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 100; trial++ {
+		g := randomAdjacency(rnd, 1+rnd.Intn(8), 0.35, 3)
+		weighted := trial%2 == 1
+		for key := range g.weight {
+			if weighted {
+				g.weight[key]++
+			} else {
+				g.weight[key] = 1
+			}
+		}
+		// an edge to a vertex which is not listed
+		g.next[g.vertices[0]] = append(g.next[g.vertices[0]], "hidden")
+		g.next["hidden"] = append([]string(nil), g.vertices...)
+		g.weight[[2]string{g.vertices[0], "hidden"}] = 1
+		for _, v := range g.vertices {
+			g.weight[[2]string{"hidden", v}] = 1
+		}
+		n := len(g.vertices)
+
+		// distances and shortest path counts between all pairs
+		dist := make(map[[2]string]float64)
+		sigma := make(map[[2]string]float64)
+		for _, s := range g.vertices {
+			dist[[2]string{s, s}] = 0
+			sigma[[2]string{s, s}] = 1
+			for changed := true; changed; {
+				changed = false
+				for _, v := range g.vertices {
+					dv, found := dist[[2]string{s, v}]
+					if !found {
+						continue
+					}
+					for _, w := range g.next[v] {
+						if w == "hidden" {
+							continue
+						}
+						d := dv + g.Weight(v, w)
+						if dw, found := dist[[2]string{s, w}]; !found || d < dw {
+							dist[[2]string{s, w}] = d
+							changed = true
+						}
+					}
+				}
+			}
+		}
+		for _, s := range g.vertices {
+			// count in order of distance: repeat n times
+			for k := 0; k < n; k++ {
+				for _, w := range g.vertices {
+					if w == s {
+						continue
+					}
+					count := 0.0
+					for _, v := range g.vertices {
+						for _, x := range g.next[v] {
+							dv, okV := dist[[2]string{s, v}]
+							dw, okW := dist[[2]string{s, w}]
+							if x == w && okV && okW && dv+g.Weight(v, w) == dw {
+								count += sigma[[2]string{s, v}]
+							}
+						}
+					}
+					sigma[[2]string{s, w}] = count
+				}
+			}
+		}
+
+		expectedBetweenness := make(map[string]float64)
+		expectedCloseness := make(map[string]float64)
+		expectedHarmonic := make(map[string]float64)
+		for _, v := range g.vertices {
+			sum, reached := 0.0, 0.0
+			for _, s := range g.vertices {
+				if d, found := dist[[2]string{v, s}]; found && s != v {
+					sum += d
+					reached++
+					expectedHarmonic[v] += 1 / d
+				}
+				for _, u := range g.vertices {
+					dsv, ok1 := dist[[2]string{s, v}]
+					dvu, ok2 := dist[[2]string{v, u}]
+					dsu := dist[[2]string{s, u}]
+					if s != v && u != v && s != u && ok1 && ok2 && dsv+dvu == dsu {
+						expectedBetweenness[v] += sigma[[2]string{s, v}] * sigma[[2]string{v, u}] / sigma[[2]string{s, u}]
+					}
+				}
+			}
+			if sum > 0 {
+				expectedCloseness[v] = reached / sum
+			}
+		}
+
+		for _, workers := range []int{1, 3} {
+			opts := PathCentralityOptions{Workers: workers}
+			var betweenness, closeness, harmonic map[string]float64
+			if weighted {
+				betweenness, closeness, harmonic = WeightedBetweenness(g, opts), WeightedCloseness(g, opts), WeightedHarmonic(g, opts)
+			} else {
+				betweenness, closeness, harmonic = Betweenness(g, opts), Closeness(g, opts), Harmonic(g, opts)
+			}
+			if len(betweenness) != n || len(closeness) != n || len(harmonic) != n {
+				t.Errorf("trial %d: unexpected number of scores", trial)
+			}
+			for _, v := range g.vertices {
+				if math.Abs(betweenness[v]-expectedBetweenness[v]) > 1e-9 {
+					t.Errorf("trial %d: betweenness of %s is %v instead of %v", trial, v, betweenness[v], expectedBetweenness[v])
+				}
+				if math.Abs(closeness[v]-expectedCloseness[v]) > 1e-9 {
+					t.Errorf("trial %d: closeness of %s is %v instead of %v", trial, v, closeness[v], expectedCloseness[v])
+				}
+				if math.Abs(harmonic[v]-expectedHarmonic[v]) > 1e-9 {
+					t.Errorf("trial %d: harmonic centrality of %s is %v instead of %v", trial, v, harmonic[v], expectedHarmonic[v])
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package graph
+
+// MaximalCliques enumerates the maximal cliques of an undirected graph:
+// the sets of pairwise adjacent vertices which are not contained in a larger one.
+// Each edge must be listed in both directions.
+// Self-loops and edges leading to vertices which are not listed by Vertices are ignored.
+//
+// Each clique is passed to fn, its vertices being in the order of Vertices.
+// The enumeration stops as soon as fn returns false.
+//
+// It is the Bron-Kerbosch algorithm with pivoting,
+// the outer level following a degeneracy ordering (Eppstein, Löffler and Strash),
+// so that it runs in O(d n 3^(d/3)) time where d is the degeneracy of the graph.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func MaximalCliques(g VertexListForward, fn func(clique []string) bool) {
+	vertices, adj := indexSimpleAdjacency(g)
+	bk := bronKerbosch{
+		vertices:   vertices,
+		neighbours: make([]bitset, len(vertices)),
+		fn:         fn,
+	}
+	for v, next := range adj {
+		bk.neighbours[v] = newBitset(len(vertices))
+		for _, w := range next {
+			bk.neighbours[v].add(w)
+		}
+	}
+
+	order, _ := coreDecomposition(adj)
+	later := newBitset(len(vertices))
+	for _, v := range order {
+		later.add(v)
+	}
+	for _, v := range order {
+		later.remove(v)
+		candidates := bk.neighbours[v].intersection(later)
+		excluded := bk.neighbours[v].difference(later)
+		if !bk.extend([]int{v}, candidates, excluded) {
+			return
+		}
+	}
+}
+
+// MaximumClique returns a clique of an undirected graph with as many vertices as possible,
+// in the order of Vertices.
+// Each edge must be listed in both directions.
+// It returns nil if the graph has no vertex.
+//
+// It enumerates maximal cliques: it may take an exponential time.
+func MaximumClique(g VertexListForward) []string {
+	var maximum []string
+	MaximalCliques(g, func(clique []string) bool {
+		if len(clique) > len(maximum) {
+			maximum = clique
+		}
+		return true
+	})
+	return maximum
+}
+
+// bronKerbosch holds the state of the Bron-Kerbosch algorithm.
+type bronKerbosch struct {
+	vertices   []string
+	neighbours []bitset
+	fn         func(clique []string) bool
+}
+
+// extend reports the maximal cliques containing the clique,
+// extended with candidates and not with excluded vertices.
+// It returns false if the enumeration must stop.
+func (bk *bronKerbosch) extend(clique []int, candidates, excluded bitset) bool {
+	if candidates.empty() {
+		if excluded.empty() {
+			return bk.report(clique)
+		}
+		return true
+	}
+
+	// pivot with the most neighbours among candidates
+	pivot, most := -1, -1
+	for _, set := range []bitset{candidates, excluded} {
+		for _, u := range set.elements() {
+			if n := candidates.intersection(bk.neighbours[u]).count(); n > most {
+				pivot, most = u, n
+			}
+		}
+	}
+
+	// candidates which are not neighbours of the pivot
+	for _, v := range candidates.elements() {
+		if bk.neighbours[pivot].has(v) {
+			continue
+		}
+		if !bk.extend(append(clique, v), candidates.intersection(bk.neighbours[v]), excluded.intersection(bk.neighbours[v])) {
+			return false
+		}
+		candidates.remove(v)
+		excluded.add(v)
+	}
+	return true
+}
+
+// report passes the clique to the callback, its vertices in the order of Vertices.
+func (bk *bronKerbosch) report(clique []int) bool {
+	in := newBitset(len(bk.vertices))
+	for _, v := range clique {
+		in.add(v)
+	}
+	names := make([]string, 0, len(clique))
+	for _, v := range in.elements() {
+		names = append(names, bk.vertices[v])
+	}
+	return bk.fn(names)
+}
@@ -0,0 +1,81 @@
+package graph
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// TestMaximalCliques compares the maximal cliques of random undirected graphs
+// with a brute force enumeration of all vertex subsets.
+func TestMaximalCliques(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 200; trial++ {
+		g := randomConnectedUndirected(rnd, 1+rnd.Intn(10), 0.2+0.6*rnd.Float64())
+		n := len(g.vertices)
+		adjacent := make(map[[2]string]bool)
+		for v, next := range g.next {
+			for _, w := range next {
+				adjacent[[2]string{v, w}] = true
+			}
+		}
+
+		isClique := func(mask int) bool {
+			for i := 0; i < n; i++ {
+				for j := i + 1; j < n; j++ {
+					if mask&(1<<uint(i)) != 0 && mask&(1<<uint(j)) != 0 && !adjacent[[2]string{g.vertices[i], g.vertices[j]}] {
+						return false
+					}
+				}
+			}
+			return true
+		}
+		expected := make(map[string]bool)
+		maximum := 0
+		for mask := 1; mask < 1<<uint(n); mask++ {
+			if !isClique(mask) {
+				continue
+			}
+			maximal := true
+			for i := 0; i < n && maximal; i++ {
+				if mask&(1<<uint(i)) == 0 && isClique(mask|1<<uint(i)) {
+					maximal = false
+				}
+			}
+			if maximal {
+				var clique []string
+				for i := 0; i < n; i++ {
+					if mask&(1<<uint(i)) != 0 {
+						clique = append(clique, g.vertices[i])
+					}
+				}
+				expected[strings.Join(clique, " ")] = true
+				if len(clique) > maximum {
+					maximum = len(clique)
+				}
+			}
+		}
+
+		found := make(map[string]bool)
+		MaximalCliques(g, func(clique []string) bool {
+			key := strings.Join(clique, " ")
+			if found[key] {
+				t.Errorf("trial %d: clique %v found twice", trial, clique)
+			}
+			found[key] = true
+			return true
+		})
+		if len(found) != len(expected) {
+			t.Errorf("trial %d: %d cliques instead of %d", trial, len(found), len(expected))
+		}
+		for key := range found {
+			if !expected[key] {
+				t.Errorf("trial %d: %s is not a maximal clique", trial, key)
+			}
+		}
+
+		if clique := MaximumClique(g); len(clique) != maximum {
+			t.Errorf("trial %d: maximum clique %v instead of size %d", trial, clique, maximum)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package graph
+
+// Reachability is the transitive closure of a directed graph.
+//
+// It is a VertexListForward graph itself:
+// the next vertices of a vertex are the vertices reachable from it
+// through a path of at least one edge.
+type Reachability struct {
+	vertices  []string
+	index     map[string]int
+	component []int      // strongly connected component of each vertex
+	members   [][]string // vertices of each component
+	reach     []bitset   // components reachable from each component
+}
+
+// TransitiveClosure computes the reachability relation of a directed graph.
+//
+// Strongly connected components are computed first:
+// all the vertices of a component reach the same vertices.
+// Reachability is then propagated along the condensation of g, in reverse topological order.
+// It runs in O(V + E·C/64) time, where C is the number of components:
+// each edge between components merges a bitset of C bits.
+// Edges leading to vertices which are not listed by Vertices are ignored.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func TransitiveClosure(g VertexListForward) *Reachability {
+	vertices := g.Vertices()
+	sub := inducedSubgraph{g: g, vertices: vertices, in: make(map[string]bool)}
+	for _, v := range vertices {
+		sub.in[v] = true
+	}
+
+	r := &Reachability{
+		vertices:  vertices,
+		index:     make(map[string]int, len(vertices)),
+		component: make([]int, len(vertices)),
+		members:   StronglyConnectedComponents(sub),
+	}
+	for i, v := range vertices {
+		r.index[v] = i
+	}
+	for c, members := range r.members {
+		for _, v := range members {
+			r.component[r.index[v]] = c
+		}
+	}
+
+	// components are in reverse topological order:
+	// next components have already been processed
+	r.reach = make([]bitset, len(r.members))
+	for c, members := range r.members {
+		r.reach[c] = newBitset(len(r.members))
+		cyclic := len(members) > 1
+		for _, v := range members {
+			for _, w := range g.NextVertices(v) {
+				j, found := r.index[w]
+				if !found {
+					continue
+				}
+				d := r.component[j]
+				if d == c {
+					cyclic = true // self-loop
+					continue
+				}
+				if !r.reach[c].has(d) {
+					r.reach[c].add(d)
+					r.reach[c].union(r.reach[d])
+				}
+			}
+		}
+		if cyclic {
+			r.reach[c].add(c)
+		}
+	}
+
+	return r
+}
+
+// Reachable returns true if there is a path of at least one edge from u to v.
+func (r *Reachability) Reachable(u, v string) bool {
+	i, okU := r.index[u]
+	j, okV := r.index[v]
+	if !okU || !okV {
+		return false
+	}
+	return r.reach[r.component[i]].has(r.component[j])
+}
+
+// Vertices returns the vertices of the graph.
+func (r *Reachability) Vertices() []string { return r.vertices }
+
+// NextVertices returns the vertices reachable from v,
+// in the order of Vertices.
+func (r *Reachability) NextVertices(v string) []string {
+	i, found := r.index[v]
+	if !found {
+		return nil
+	}
+
+	var next []string
+	reach := r.reach[r.component[i]]
+	for j, w := range r.vertices {
+		if reach.has(r.component[j]) {
+			next = append(next, w)
+		}
+	}
+	return next
+}
+
+// TransitiveReduction computes the transitive reduction of a directed acyclic graph:
+// the graph with the fewest edges having the same reachability relation.
+// An edge from u to v is kept if and only if there is no other path from u to v.
+// Parallel edges are merged.
+// Edges leading to vertices which are not listed by Vertices are ignored.
+// It returns ErrCycle if g is not a directed acyclic graph.
+//
+// The returned graph lists the vertices in the order of Vertices
+// and the next vertices in the order of NextVertices.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func TransitiveReduction(g VertexListForward) (VertexListForward, error) {
+	closure := TransitiveClosure(g)
+	for c := range closure.members {
+		if closure.reach[c].has(c) {
+			return nil, ErrCycle
+		}
+	}
+
+	reduction := &adjacencyList{
+		vertices: closure.vertices,
+		next:     make(map[string][]string),
+	}
+	for _, v := range closure.vertices {
+		// distinct successors of v
+		var successors []string
+		seen := make(map[string]bool)
+		for _, w := range g.NextVertices(v) {
+			if _, found := closure.index[w]; found && !seen[w] {
+				seen[w] = true
+				successors = append(successors, w)
+			}
+		}
+
+		// keep edges to successors which are not reachable from other successors
+		for _, w := range successors {
+			redundant := false
+			for _, u := range successors {
+				if u != w && closure.Reachable(u, w) {
+					redundant = true
+					break
+				}
+			}
+			if !redundant {
+				reduction.next[v] = append(reduction.next[v], w)
+			}
+		}
+	}
+
+	return reduction, nil
+}
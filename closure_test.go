@@ -0,0 +1,136 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// bruteForceReachable returns true if there is a path of at least one edge from u to v in g.
+func bruteForceReachable(g adjacency, u, v string) bool {
+	visited := make(map[string]bool)
+	stack := append([]string(nil), g.next[u]...)
+	for len(stack) != 0 {
+		w := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if w == v {
+			return true
+		}
+		if !visited[w] {
+			visited[w] = true
+			stack = append(stack, g.next[w]...)
+		}
+	}
+	return false
+}
+
+// TestTransitiveClosure compares the reachability relation on random directed graphs
+// with a brute force search.
+func TestTransitiveClosure(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 200; trial++ {
+		g := randomAdjacency(rnd, 1+rnd.Intn(10), 0.2, 1)
+		closure := TransitiveClosure(g)
+
+		for _, u := range g.vertices {
+			next := make(map[string]bool)
+			for _, v := range closure.NextVertices(u) {
+				next[v] = true
+			}
+			for _, v := range g.vertices {
+				expected := bruteForceReachable(g, u, v)
+				if closure.Reachable(u, v) != expected {
+					t.Errorf("trial %d: reachable(%s, %s) should be %v", trial, u, v, expected)
+				}
+				if next[v] != expected {
+					t.Errorf("trial %d: %s in next vertices of %s should be %v", trial, v, u, expected)
+				}
+			}
+		}
+	}
+}
+
+// TestTransitiveReduction checks on random directed acyclic graphs that the reduction
+// has the same reachability relation and that no edge can be removed.
+func TestTransitiveReduction(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 200; trial++ {
+		g := randomAdjacency(rnd, 1+rnd.Intn(10), 0.4, 1)
+		// keep forward edges only to make the graph acyclic
+		for i, v := range g.vertices {
+			var next []string
+			for _, w := range g.next[v] {
+				for _, x := range g.vertices[i+1:] {
+					if w == x {
+						next = append(next, w)
+					}
+				}
+			}
+			g.next[v] = next
+		}
+
+		reduction, err := TransitiveReduction(g)
+		if err != nil {
+			t.Fatalf("trial %d: unexpected error %v", trial, err)
+		}
+		r := adjacency{vertices: reduction.Vertices(), next: make(map[string][]string)}
+		for _, v := range r.vertices {
+			r.next[v] = reduction.NextVertices(v)
+		}
+
+		for _, u := range g.vertices {
+			for _, v := range g.vertices {
+				if bruteForceReachable(r, u, v) != bruteForceReachable(g, u, v) {
+					t.Errorf("trial %d: reachability from %s to %s is not preserved", trial, u, v)
+				}
+			}
+			// removing any edge changes reachability
+			for i, v := range r.next[u] {
+				without := adjacency{vertices: r.vertices, next: make(map[string][]string)}
+				for w, next := range r.next {
+					without.next[w] = next
+				}
+				without.next[u] = append(append([]string(nil), r.next[u][:i]...), r.next[u][i+1:]...)
+				if bruteForceReachable(without, u, v) {
+					t.Errorf("trial %d: edge %s -> %s is redundant", trial, u, v)
+				}
+			}
+		}
+	}
+
+	cyclic := adjacency{
+		vertices: []string{"a", "b"},
+		next:     map[string][]string{"a": {"b"}, "b": {"a"}},
+	}
+	if _, err := TransitiveReduction(cyclic); err != ErrCycle {
+		t.Errorf("expected ErrCycle, got %v", err)
+	}
+}
+
+func TestTransitiveClosureUnlistedVertex(t *testing.T) {
+	g := adjacency{
+		vertices: []string{"a", "b", "c"},
+		next:     map[string][]string{"a": {"c"}, "b": {"x"}, "x": {"a"}},
+	}
+
+	closure := TransitiveClosure(g)
+	if !closure.Reachable("a", "c") {
+		t.Errorf("c should be reachable from a")
+	}
+	if closure.Reachable("b", "a") {
+		t.Errorf("a should not be reachable from b through x")
+	}
+	if next := closure.NextVertices("a"); len(next) != 1 || next[0] != "c" {
+		t.Errorf("wrong vertices reachable from a: %v instead of [c]", next)
+	}
+
+	reduction, err := TransitiveReduction(g)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if next := reduction.NextVertices("b"); len(next) != 0 {
+		t.Errorf("wrong reduction of b: %v instead of no edge", next)
+	}
+	if next := reduction.NextVertices("a"); len(next) != 1 || next[0] != "c" {
+		t.Errorf("wrong reduction of a: %v instead of [c]", next)
+	}
+}
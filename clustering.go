@@ -0,0 +1,100 @@
+package graph
+
+// Triangles returns the number of triangles each vertex of an undirected graph belongs to.
+// Each edge must be listed in both directions.
+// Self-loops, parallel edges and edges leading to vertices which are not listed by Vertices are ignored.
+//
+// Edges are oriented from the vertex of smaller degree to the vertex of larger degree,
+// so that each triangle is found once, in O(E^1.5) time.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func Triangles(g VertexListForward) map[string]int {
+	vertices, adj := indexSimpleAdjacency(g)
+	count := triangles(adj)
+	result := make(map[string]int, len(vertices))
+	for i, v := range vertices {
+		result[v] = count[i]
+	}
+	return result
+}
+
+// LocalClustering returns the local clustering coefficient of each vertex of an undirected graph:
+// the fraction of pairs of its neighbours which are adjacent.
+// It is zero for vertices with less than two neighbours.
+// Each edge must be listed in both directions.
+// Self-loops, parallel edges and edges leading to vertices which are not listed by Vertices are ignored.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func LocalClustering(g VertexListForward) map[string]float64 {
+	vertices, adj := indexSimpleAdjacency(g)
+	count := triangles(adj)
+	result := make(map[string]float64, len(vertices))
+	for i, v := range vertices {
+		result[v] = 0
+		if d := len(adj[i]); d > 1 {
+			result[v] = 2 * float64(count[i]) / float64(d*(d-1))
+		}
+	}
+	return result
+}
+
+// GlobalClustering returns the global clustering coefficient, or transitivity, of an undirected graph:
+// the fraction of paths of length two whose ends are adjacent,
+// i.e. three times the number of triangles divided by the number of paths of length two.
+// It is zero if there is no such path.
+// Each edge must be listed in both directions.
+// Self-loops, parallel edges and edges leading to vertices which are not listed by Vertices are ignored.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func GlobalClustering(g VertexListForward) float64 {
+	_, adj := indexSimpleAdjacency(g)
+	closed, paths := 0, 0
+	for i, n := range triangles(adj) {
+		// each triangle is counted once per vertex
+		closed += n
+		d := len(adj[i])
+		paths += d * (d - 1) / 2
+	}
+	if paths == 0 {
+		return 0
+	}
+	return float64(closed) / float64(paths)
+}
+
+// triangles returns the number of triangles each vertex belongs to.
+func triangles(adj [][]int) []int {
+	n := len(adj)
+	// v precedes w if it has a smaller degree, ties being broken by index
+	precedes := func(v, w int) bool {
+		return len(adj[v]) < len(adj[w]) || (len(adj[v]) == len(adj[w]) && v < w)
+	}
+	out := make([][]int, n)
+	for v, next := range adj {
+		for _, w := range next {
+			if precedes(v, w) {
+				out[v] = append(out[v], w)
+			}
+		}
+	}
+
+	count := make([]int, n)
+	mark := make([]int, n) // vertex having marked each vertex as an out neighbour, plus one
+	for v := range out {
+		for _, w := range out[v] {
+			mark[w] = v + 1
+		}
+		for _, u := range out[v] {
+			for _, w := range out[u] {
+				if mark[w] == v+1 {
+					count[v]++
+					count[u]++
+					count[w]++
+				}
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,101 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// TestCoreNumbersAndClustering compares core numbers, triangle counts and clustering coefficients
+// of random undirected graphs with brute force computations.
+func TestCoreNumbersAndClustering(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 200; trial++ {
+		g := randomConnectedUndirected(rnd, 1+rnd.Intn(12), rnd.Float64())
+		if rnd.Intn(2) == 0 {
+			// a self-loop and a parallel edge are ignored
+			v := g.vertices[0]
+			g.next[v] = append(g.next[v], v)
+			if len(g.next[v]) > 1 {
+				g.next[v] = append(g.next[v], g.next[v][0])
+			}
+		}
+		adjacent := make(map[[2]string]bool)
+		neighbours := make(map[string]map[string]bool)
+		for _, v := range g.vertices {
+			neighbours[v] = make(map[string]bool)
+			for _, w := range g.next[v] {
+				if w != v {
+					adjacent[[2]string{v, w}] = true
+					neighbours[v][w] = true
+				}
+			}
+		}
+
+		// core numbers by peeling k-cores
+		core := CoreNumbers(g)
+		for k := 0; k <= len(g.vertices); k++ {
+			in := make(map[string]bool)
+			for _, v := range g.vertices {
+				in[v] = true
+			}
+			for changed := true; changed; {
+				changed = false
+				for v := range in {
+					degree := 0
+					for w := range neighbours[v] {
+						if in[w] {
+							degree++
+						}
+					}
+					if degree < k {
+						delete(in, v)
+						changed = true
+					}
+				}
+			}
+			for _, v := range g.vertices {
+				if in[v] != (core[v] >= k) {
+					t.Errorf("trial %d: vertex %s in %d-core should be %v", trial, v, k, in[v])
+				}
+			}
+		}
+
+		// triangles and clustering
+		count := Triangles(g)
+		local := LocalClustering(g)
+		closed, paths := 0.0, 0.0
+		for _, v := range g.vertices {
+			expected, pairs := 0, 0
+			for _, u := range g.vertices {
+				for _, w := range g.vertices {
+					if u < w && neighbours[v][u] && neighbours[v][w] {
+						pairs++
+						if adjacent[[2]string{u, w}] {
+							expected++
+						}
+					}
+				}
+			}
+			if count[v] != expected {
+				t.Errorf("trial %d: %s is in %d triangles instead of %d", trial, v, count[v], expected)
+			}
+			coefficient := 0.0
+			if pairs > 0 {
+				coefficient = float64(expected) / float64(pairs)
+			}
+			if math.Abs(local[v]-coefficient) > 1e-12 {
+				t.Errorf("trial %d: local clustering of %s is %v instead of %v", trial, v, local[v], coefficient)
+			}
+			closed += float64(expected)
+			paths += float64(pairs)
+		}
+		global := 0.0
+		if paths > 0 {
+			global = closed / paths
+		}
+		if c := GlobalClustering(g); math.Abs(c-global) > 1e-12 {
+			t.Errorf("trial %d: global clustering is %v instead of %v", trial, c, global)
+		}
+	}
+}
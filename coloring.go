@@ -0,0 +1,184 @@
+package graph
+
+import "sort"
+
+// GreedyColoring colors the vertices of an undirected graph
+// so that no edge links two vertices of the same color.
+// Each edge must be listed in both directions.
+// Self-loops and edges leading to vertices which are not listed by Vertices are ignored.
+//
+// Vertices are colored in the given order, each one with the smallest color not used by its neighbours.
+// Vertices missing from the order are colored afterwards in the order of Vertices,
+// so that a nil order colors vertices in the order of Vertices.
+// The quality of the coloring depends on the order: see LargestFirstOrder and SmallestLastOrder.
+//
+// It returns the color of each vertex, from zero, and the number of colors.
+// It runs in O(V+E) time.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func GreedyColoring(g VertexListForward, order []string) (map[string]int, int) {
+	vertices, adj := indexSimpleAdjacency(g)
+	index := make(map[string]int, len(vertices))
+	for i, v := range vertices {
+		index[v] = i
+	}
+
+	color := make([]int, len(vertices))
+	for i := range color {
+		color[i] = -1
+	}
+	used := make([]int, len(vertices)+1) // last vertex using each color, plus one
+	colors := 0
+	paint := func(v int) {
+		if color[v] >= 0 {
+			return
+		}
+		for _, w := range adj[v] {
+			if color[w] >= 0 {
+				used[color[w]] = v + 1
+			}
+		}
+		c := 0
+		for used[c] == v+1 {
+			c++
+		}
+		color[v] = c
+		if c >= colors {
+			colors = c + 1
+		}
+	}
+	for _, v := range order {
+		if i, found := index[v]; found {
+			paint(i)
+		}
+	}
+	for i := range vertices {
+		paint(i)
+	}
+
+	return namedColoring(vertices, color), colors
+}
+
+// LargestFirstOrder returns the vertices of an undirected graph by decreasing degree,
+// ties being broken in the order of Vertices.
+// Self-loops, parallel edges and edges leading to vertices which are not listed by Vertices are ignored.
+func LargestFirstOrder(g VertexListForward) []string {
+	vertices, adj := indexSimpleAdjacency(g)
+	indices := make([]int, len(vertices))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool { return len(adj[indices[i]]) > len(adj[indices[j]]) })
+
+	order := make([]string, len(vertices))
+	for i, v := range indices {
+		order[i] = vertices[v]
+	}
+	return order
+}
+
+// SmallestLastOrder returns the vertices of an undirected graph in smallest-last order:
+// the last vertex has the smallest degree, the previous one has the smallest degree once the last one is removed, etc.
+// Coloring greedily in this order uses at most d+1 colors, where d is the degeneracy of the graph.
+// Self-loops, parallel edges and edges leading to vertices which are not listed by Vertices are ignored.
+func SmallestLastOrder(g VertexListForward) []string {
+	vertices, adj := indexSimpleAdjacency(g)
+	removal, _ := coreDecomposition(adj)
+	order := make([]string, len(vertices))
+	for i, v := range removal {
+		order[len(order)-1-i] = vertices[v]
+	}
+	return order
+}
+
+// DSaturColoring colors the vertices of an undirected graph
+// so that no edge links two vertices of the same color.
+// Each edge must be listed in both directions.
+// Self-loops and edges leading to vertices which are not listed by Vertices are ignored.
+//
+// It is Brélaz's heuristic: the next vertex to color is the one with the most distinct colors among its neighbours,
+// ties being broken by the largest degree, then in the order of Vertices.
+// It is colored with the smallest color not used by its neighbours.
+//
+// It returns the color of each vertex, from zero, and the number of colors.
+// It runs in O(V^2+E) time.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func DSaturColoring(g VertexListForward) (map[string]int, int) {
+	vertices, adj := indexSimpleAdjacency(g)
+	n := len(vertices)
+	color := make([]int, n)
+	for i := range color {
+		color[i] = -1
+	}
+	// colors used by the neighbours of each vertex: their number is the saturation
+	neighbourColors := make([]map[int]bool, n)
+	for i := range neighbourColors {
+		neighbourColors[i] = make(map[int]bool)
+	}
+
+	colors := 0
+	for step := 0; step < n; step++ {
+		// most saturated uncolored vertex
+		v := -1
+		for i := range vertices {
+			if color[i] >= 0 {
+				continue
+			}
+			si := len(neighbourColors[i])
+			if v < 0 || si > len(neighbourColors[v]) || (si == len(neighbourColors[v]) && len(adj[i]) > len(adj[v])) {
+				v = i
+			}
+		}
+
+		c := 0
+		for neighbourColors[v][c] {
+			c++
+		}
+		color[v] = c
+		if c >= colors {
+			colors = c + 1
+		}
+		for _, w := range adj[v] {
+			neighbourColors[w][c] = true
+		}
+	}
+
+	return namedColoring(vertices, color), colors
+}
+
+// IsProperColoring returns true if every vertex of g is colored
+// and no edge links two vertices of the same color.
+// Self-loops and edges leading to vertices which are not listed by Vertices are ignored.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func IsProperColoring(g VertexListForward, coloring map[string]int) bool {
+	vertices := g.Vertices()
+	listed := make(map[string]bool, len(vertices))
+	for _, v := range vertices {
+		if _, colored := coloring[v]; !colored {
+			return false
+		}
+		listed[v] = true
+	}
+	for _, v := range vertices {
+		for _, w := range g.NextVertices(v) {
+			if w != v && listed[w] && coloring[v] == coloring[w] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// namedColoring returns the color of each vertex.
+func namedColoring(vertices []string, color []int) map[string]int {
+	coloring := make(map[string]int, len(vertices))
+	for i, v := range vertices {
+		coloring[v] = color[i]
+	}
+	return coloring
+}
@@ -0,0 +1,77 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// TestColoring checks on random undirected graphs that colorings are proper,
+// use the announced number of colors and respect the known bounds.
+func TestColoring(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 200; trial++ {
+		g := randomConnectedUndirected(rnd, 1+rnd.Intn(15), rnd.Float64())
+		_, adj := indexSimpleAdjacency(g)
+		maxDegree := 0
+		for _, next := range adj {
+			if len(next) > maxDegree {
+				maxDegree = len(next)
+			}
+		}
+		_, core := coreDecomposition(adj)
+		degeneracy := 0
+		for _, k := range core {
+			if k > degeneracy {
+				degeneracy = k
+			}
+		}
+
+		check := func(name string, coloring map[string]int, colors, bound int) {
+			if !IsProperColoring(g, coloring) {
+				t.Errorf("trial %d: %s coloring is not proper", trial, name)
+			}
+			used := make(map[int]bool)
+			for _, c := range coloring {
+				if c < 0 || c >= colors {
+					t.Errorf("trial %d: %s color %d out of range", trial, name, c)
+				}
+				used[c] = true
+			}
+			if len(used) != colors {
+				t.Errorf("trial %d: %s uses %d colors instead of %d", trial, name, len(used), colors)
+			}
+			if colors > bound {
+				t.Errorf("trial %d: %s uses %d colors, more than %d", trial, name, colors, bound)
+			}
+		}
+
+		coloring, colors := GreedyColoring(g, nil)
+		check("natural", coloring, colors, maxDegree+1)
+		coloring, colors = GreedyColoring(g, LargestFirstOrder(g))
+		check("largest first", coloring, colors, maxDegree+1)
+		coloring, colors = GreedyColoring(g, SmallestLastOrder(g))
+		check("smallest last", coloring, colors, degeneracy+1)
+		coloring, colors = DSaturColoring(g)
+		check("DSatur", coloring, colors, maxDegree+1)
+
+		// a conflict is detected
+		if len(g.vertices) > 1 {
+			v := g.vertices[0]
+			coloring[g.next[v][0]] = coloring[v]
+			if IsProperColoring(g, coloring) {
+				t.Errorf("trial %d: conflict not detected", trial)
+			}
+		}
+	}
+
+	// DSatur is exact on bipartite graphs
+	for trial := 0; trial < 50; trial++ {
+		g := randomConnectedUndirected(rnd, 2+rnd.Intn(15), 0.05)
+		if bipartite, _, _ := IsBipartite(g); !bipartite {
+			continue
+		}
+		if _, colors := DSaturColoring(g); colors != 2 {
+			t.Errorf("trial %d: %d colors for a bipartite graph", trial, colors)
+		}
+	}
+}
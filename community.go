@@ -0,0 +1,305 @@
+package graph
+
+import "math/rand"
+
+// CommunityOptions configures community detection.
+type CommunityOptions struct {
+	// Seed initialises the random number generator choosing the order in which vertices are examined
+	// and breaking ties.
+	// Results are deterministic for a given seed.
+	Seed int64
+
+	// MaxIterations is the maximum number of passes over the vertices.
+	// It defaults to 100.
+	MaxIterations int
+}
+
+// Louvain detects communities of a weighted undirected graph by modularity optimisation.
+// Each edge must be listed in both directions with the same positive weight.
+//
+// It returns the community of each vertex, numbered from zero in the order of Vertices,
+// and the modularity of the partition.
+//
+// It is the method of Blondel et al.: vertices are greedily moved to the neighbouring community
+// giving the largest modularity gain until no move improves it,
+// then communities are aggregated into vertices and the process is repeated on the aggregated graph.
+// Edges leading to vertices which are not listed by Vertices are ignored.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func Louvain(g VertexListWeightForward, opts CommunityOptions) (map[string]int, float64) {
+	if opts.MaxIterations == 0 {
+		opts.MaxIterations = 100
+	}
+	rnd := rand.New(rand.NewSource(opts.Seed))
+	vertices, arcs := indexWeightedAdjacency(g)
+
+	// community of each original vertex
+	community := make([]int, len(vertices))
+	for i := range community {
+		community[i] = i
+	}
+
+	for {
+		level, moved := louvainLevel(arcs, rnd, opts.MaxIterations)
+		if !moved {
+			break
+		}
+
+		// renumber communities of the level
+		number := make(map[int]int)
+		for i, c := range level {
+			if _, found := number[c]; !found {
+				number[c] = len(number)
+			}
+			level[i] = number[c]
+		}
+		for i, c := range community {
+			community[i] = level[c]
+		}
+		if len(number) == len(arcs) {
+			// vertices have only been relabelled
+			break
+		}
+
+		// aggregate communities into vertices
+		weights := make([]map[int]float64, len(number))
+		for c := range weights {
+			weights[c] = make(map[int]float64)
+		}
+		for v, out := range arcs {
+			for _, a := range out {
+				weights[level[v]][level[a.to]] += a.weight
+			}
+		}
+		arcs = make([][]weightedArc, len(number))
+		for c := range arcs {
+			for d := 0; d < len(number); d++ {
+				if w, found := weights[c][d]; found {
+					arcs[c] = append(arcs[c], weightedArc{to: d, weight: w})
+				}
+			}
+		}
+	}
+
+	return namedCommunities(g, vertices, community)
+}
+
+// louvainLevel moves vertices between communities until no move improves modularity.
+// It returns the community of each vertex and whether any vertex has moved.
+func louvainLevel(arcs [][]weightedArc, rnd *rand.Rand, maxIterations int) ([]int, bool) {
+	n := len(arcs)
+	community := make([]int, n)
+	degree := make([]float64, n) // weighted degree of each vertex
+	total := make([]float64, n)  // sum of the degrees of each community
+	m2 := 0.0                    // twice the total weight
+	for v, out := range arcs {
+		community[v] = v
+		for _, a := range out {
+			degree[v] += a.weight
+		}
+		total[v] = degree[v]
+		m2 += degree[v]
+	}
+	if m2 == 0 {
+		return community, false
+	}
+
+	moved := false
+	links := make(map[int]float64) // weight from the current vertex to neighbouring communities
+	for iteration := 0; iteration < maxIterations; iteration++ {
+		improved := false
+		for _, v := range rnd.Perm(n) {
+			// remove v from its community
+			current := community[v]
+			total[current] -= degree[v]
+			for c := range links {
+				delete(links, c)
+			}
+			links[current] = 0
+			var candidates []int
+			for _, a := range arcs[v] {
+				if a.to == v {
+					continue
+				}
+				c := community[a.to]
+				if _, found := links[c]; !found {
+					candidates = append(candidates, c)
+				}
+				links[c] += a.weight
+			}
+
+			// best community, staying in the current one unless strictly better
+			best := current
+			bestGain := links[current] - total[current]*degree[v]/m2
+			for _, c := range candidates {
+				if gain := links[c] - total[c]*degree[v]/m2; gain > bestGain {
+					best, bestGain = c, gain
+				}
+			}
+
+			community[v] = best
+			total[best] += degree[v]
+			if best != current {
+				improved = true
+				moved = true
+			}
+		}
+		if !improved {
+			break
+		}
+	}
+	return community, moved
+}
+
+// LabelPropagation detects communities of a weighted undirected graph by asynchronous label propagation.
+// Each edge must be listed in both directions with the same positive weight.
+//
+// It returns the community of each vertex, numbered from zero in the order of Vertices,
+// and the modularity of the partition.
+//
+// Each vertex starts with its own label.
+// Vertices are then examined in random order and adopt the label of largest total weight among their neighbours,
+// ties being broken at random unless the current label is among them,
+// until no label changes.
+// Edges leading to vertices which are not listed by Vertices are ignored.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func LabelPropagation(g VertexListWeightForward, opts CommunityOptions) (map[string]int, float64) {
+	if opts.MaxIterations == 0 {
+		opts.MaxIterations = 100
+	}
+	rnd := rand.New(rand.NewSource(opts.Seed))
+	vertices, arcs := indexWeightedAdjacency(g)
+
+	label := make([]int, len(vertices))
+	for i := range label {
+		label[i] = i
+	}
+	weights := make(map[int]float64)
+	for iteration := 0; iteration < opts.MaxIterations; iteration++ {
+		changed := false
+		for _, v := range rnd.Perm(len(vertices)) {
+			for l := range weights {
+				delete(weights, l)
+			}
+			var labels []int
+			for _, a := range arcs[v] {
+				if a.to == v {
+					continue
+				}
+				l := label[a.to]
+				if _, found := weights[l]; !found {
+					labels = append(labels, l)
+				}
+				weights[l] += a.weight
+			}
+			if len(labels) == 0 {
+				continue
+			}
+
+			// labels of largest weight
+			var best []int
+			for _, l := range labels {
+				switch {
+				case len(best) == 0 || weights[l] > weights[best[0]]:
+					best = append(best[:0], l)
+				case weights[l] == weights[best[0]]:
+					best = append(best, l)
+				}
+			}
+			if weights[label[v]] == weights[best[0]] {
+				continue
+			}
+			label[v] = best[rnd.Intn(len(best))]
+			changed = true
+		}
+		if !changed {
+			break
+		}
+	}
+
+	return namedCommunities(g, vertices, label)
+}
+
+// Modularity returns the modularity of a partition of a weighted undirected graph into communities:
+// the fraction of the weight inside communities minus its expected value
+// if edges were rewired at random keeping weighted degrees.
+// Each edge must be listed in both directions.
+// Edges leading to vertices which are not listed by Vertices or not in a community are ignored.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func Modularity(g VertexListWeightForward, community map[string]int) float64 {
+	inside := make(map[int]float64) // weight of the edges inside each community
+	total := make(map[int]float64)  // sum of the degrees of each community
+	m2 := 0.0
+	for _, v := range g.Vertices() {
+		c, found := community[v]
+		if !found {
+			continue
+		}
+		for _, w := range g.NextVertices(v) {
+			d, found := community[w]
+			if !found {
+				continue
+			}
+			x := g.Weight(v, w)
+			total[c] += x
+			m2 += x
+			if c == d {
+				inside[c] += x
+			}
+		}
+	}
+	if m2 == 0 {
+		return 0
+	}
+
+	q := 0.0
+	for c, t := range total {
+		q += inside[c]/m2 - (t/m2)*(t/m2)
+	}
+	return q
+}
+
+// namedCommunities renumbers communities from zero in the order of vertices
+// and computes the modularity of the partition.
+func namedCommunities(g VertexListWeightForward, vertices []string, community []int) (map[string]int, float64) {
+	number := make(map[int]int)
+	named := make(map[string]int, len(vertices))
+	for i, v := range vertices {
+		c := community[i]
+		if _, found := number[c]; !found {
+			number[c] = len(number)
+		}
+		named[v] = number[c]
+	}
+	return named, Modularity(g, named)
+}
+
+// weightedArc is an edge to the vertex of index to.
+type weightedArc struct {
+	to     int
+	weight float64
+}
+
+// indexWeightedAdjacency returns the vertices of g and the weighted edges leaving each vertex.
+// Edges leading to vertices which are not listed are ignored.
+func indexWeightedAdjacency(g VertexListWeightForward) ([]string, [][]weightedArc) {
+	vertices := g.Vertices()
+	index := make(map[string]int, len(vertices))
+	for i, v := range vertices {
+		index[v] = i
+	}
+	arcs := make([][]weightedArc, len(vertices))
+	for i, v := range vertices {
+		for _, w := range g.NextVertices(v) {
+			if j, found := index[w]; found {
+				arcs[i] = append(arcs[i], weightedArc{to: j, weight: g.Weight(v, w)})
+			}
+		}
+	}
+	return vertices, arcs
+}
@@ -0,0 +1,158 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+// ringOfCliques returns k cliques of size s, consecutive cliques being linked by a single edge.
+// Vertex i*s+j is the j-th vertex of the i-th clique.
+func ringOfCliques(k, s int) adjacency {
+	g := adjacency{
+		next:   make(map[string][]string),
+		weight: make(map[[2]string]float64),
+	}
+	link := func(u, v int) {
+		a, b := strconv.Itoa(u), strconv.Itoa(v)
+		g.next[a] = append(g.next[a], b)
+		g.next[b] = append(g.next[b], a)
+		g.weight[[2]string{a, b}] = 1
+		g.weight[[2]string{b, a}] = 1
+	}
+	for i := 0; i < k*s; i++ {
+		g.vertices = append(g.vertices, strconv.Itoa(i))
+	}
+	for c := 0; c < k; c++ {
+		for i := 0; i < s; i++ {
+			for j := i + 1; j < s; j++ {
+				link(c*s+i, c*s+j)
+			}
+		}
+		link(c*s, ((c+1)%k)*s+1)
+	}
+	return g
+}
+
+// randomWeightedUndirected returns a random connected undirected graph
+// with symmetric weights between 1 and 3.
+func randomWeightedUndirected(rnd *rand.Rand, n int, p float64) adjacency {
+	g := randomConnectedUndirected(rnd, n, p)
+	for _, v := range g.vertices {
+		for _, w := range g.next[v] {
+			if v < w {
+				x := float64(1 + rnd.Intn(3))
+				g.weight[[2]string{v, w}], g.weight[[2]string{w, v}] = x, x
+			}
+		}
+	}
+	return g
+}
+
+func TestModularity(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 100; trial++ {
+		g := randomWeightedUndirected(rnd, 1+rnd.Intn(8), 0.3)
+		community := make(map[string]int)
+		for _, v := range g.vertices {
+			community[v] = rnd.Intn(3)
+		}
+
+		// sum over pairs of vertices
+		degree := make(map[string]float64)
+		m2 := 0.0
+		for _, v := range g.vertices {
+			for _, w := range g.next[v] {
+				degree[v] += g.Weight(v, w)
+				m2 += g.Weight(v, w)
+			}
+		}
+		expected := 0.0
+		for _, v := range g.vertices {
+			for _, w := range g.vertices {
+				if community[v] == community[w] {
+					expected += g.Weight(v, w) - degree[v]*degree[w]/m2
+				}
+			}
+		}
+		if m2 > 0 {
+			expected /= m2
+		}
+
+		if q := Modularity(g, community); math.Abs(q-expected) > 1e-12 {
+			t.Errorf("trial %d: modularity is %v instead of %v", trial, q, expected)
+		}
+	}
+}
+
+func TestLouvain(t *testing.T) {
+	g := ringOfCliques(6, 5)
+	for seed := int64(0); seed < 10; seed++ {
+		community, q := Louvain(g, CommunityOptions{Seed: seed})
+		for i := 0; i < 30; i++ {
+			if community[strconv.Itoa(i)] != i/5 {
+				t.Errorf("seed %d: vertex %d is in community %d instead of %d", seed, i, community[strconv.Itoa(i)], i/5)
+			}
+		}
+		if math.Abs(q-Modularity(g, community)) > 1e-12 {
+			t.Errorf("seed %d: wrong modularity %v", seed, q)
+		}
+
+		again, _ := Louvain(g, CommunityOptions{Seed: seed})
+		for v, c := range community {
+			if again[v] != c {
+				t.Errorf("seed %d: non deterministic result", seed)
+				break
+			}
+		}
+	}
+
+	// at least as good as singletons and a single community on random graphs
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 50; trial++ {
+		g := randomWeightedUndirected(rnd, 1+rnd.Intn(12), 0.3)
+		_, q := Louvain(g, CommunityOptions{Seed: int64(trial)})
+		singletons := make(map[string]int)
+		for i, v := range g.vertices {
+			singletons[v] = i
+		}
+		if q < -1e-12 || q < Modularity(g, singletons)-1e-12 {
+			t.Errorf("trial %d: modularity %v is too low", trial, q)
+		}
+	}
+}
+
+func TestLabelPropagation(t *testing.T) {
+	g := ringOfCliques(6, 5)
+	for seed := int64(0); seed < 10; seed++ {
+		// cliques may be merged but are never split
+		community, q := LabelPropagation(g, CommunityOptions{Seed: seed})
+		for i := 0; i < 30; i++ {
+			if community[strconv.Itoa(i)] != community[strconv.Itoa(i/5*5)] {
+				t.Errorf("seed %d: clique %d is split", seed, i/5)
+			}
+		}
+		if math.Abs(q-Modularity(g, community)) > 1e-12 {
+			t.Errorf("seed %d: wrong modularity %v", seed, q)
+		}
+	}
+
+	// each vertex has a label of largest weight among its neighbours
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 50; trial++ {
+		g := randomWeightedUndirected(rnd, 1+rnd.Intn(12), 0.3)
+		community, _ := LabelPropagation(g, CommunityOptions{Seed: int64(trial)})
+		for _, v := range g.vertices {
+			weights := make(map[int]float64)
+			best := 0.0
+			for _, w := range g.next[v] {
+				weights[community[w]] += g.Weight(v, w)
+				best = math.Max(best, weights[community[w]])
+			}
+			if len(g.next[v]) != 0 && weights[community[v]] != best {
+				t.Errorf("trial %d: %s does not have a label of largest weight", trial, v)
+			}
+		}
+	}
+}
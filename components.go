@@ -0,0 +1,94 @@
+package graph
+
+// ConnectedComponents labels the connected components of an undirected graph.
+// Each edge must be listed in both directions.
+// Edges leading to vertices which are not listed by Vertices are ignored.
+//
+// It returns the component of each vertex and the size of each component.
+// Components are numbered from zero, in the order in which Vertices lists them.
+//
+// It runs a breadth-first visit from each vertex not visited yet, in linear time.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func ConnectedComponents(g VertexListForward) (component map[string]int, sizes []int) {
+	sub := inducedSubgraph{g: g, vertices: g.Vertices(), in: make(map[string]bool)}
+	for _, v := range sub.vertices {
+		sub.in[v] = true
+	}
+
+	vis := &componentVisitor{component: make(map[string]int)}
+	for _, v := range sub.vertices {
+		if _, visited := vis.component[v]; !visited {
+			vis.sizes = append(vis.sizes, 0)
+			BreadthFirstVisit(sub, vis, v)
+		}
+	}
+	return vis.component, vis.sizes
+}
+
+// componentVisitor is a BfsVisitor labelling discovered vertices
+// with the last component.
+type componentVisitor struct {
+	component map[string]int
+	sizes     []int
+}
+
+func (vis *componentVisitor) ExamineVertex(string)       {}
+func (vis *componentVisitor) ExamineEdge(string, string) {}
+func (vis *componentVisitor) TreeEdge(string, string)    {}
+func (vis *componentVisitor) NonTreeEdge(string, string) {}
+func (vis *componentVisitor) GrayTarget(string, string)  {}
+func (vis *componentVisitor) BlackTarget(string, string) {}
+func (vis *componentVisitor) FinishVertex(string)        {}
+
+func (vis *componentVisitor) DiscoverVertex(v string) {
+	id := len(vis.sizes) - 1
+	vis.component[v] = id
+	vis.sizes[id]++
+}
+
+// WeaklyConnectedComponents labels the weakly connected components of a directed graph,
+// i.e. the connected components of the graph when edge directions are ignored.
+// Edges leading to vertices which are not listed by Vertices are ignored.
+//
+// It returns the component of each vertex and the size of each component.
+// Components are numbered from zero, in the order in which Vertices lists them.
+//
+// It merges the endpoints of each edge in a union-find structure,
+// in almost linear time.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func WeaklyConnectedComponents(g VertexListForward) (component map[string]int, sizes []int) {
+	vertices := g.Vertices()
+	index := make(map[string]int, len(vertices))
+	for i, v := range vertices {
+		index[v] = i
+	}
+
+	uf := newUnionFind(len(vertices))
+	for i, v := range vertices {
+		for _, w := range g.NextVertices(v) {
+			if j, found := index[w]; found {
+				uf.union(i, j)
+			}
+		}
+	}
+
+	// number the sets
+	component = make(map[string]int, len(vertices))
+	id := make(map[int]int) // component of each representative
+	for i, v := range vertices {
+		root := uf.find(i)
+		c, found := id[root]
+		if !found {
+			c = len(sizes)
+			id[root] = c
+			sizes = append(sizes, 0)
+		}
+		component[v] = c
+		sizes[c]++
+	}
+	return component, sizes
+}
@@ -0,0 +1,89 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// reachableListed returns the vertices reachable from v through listed vertices only.
+func reachableListed(g adjacency, v string, listed map[string]bool) map[string]bool {
+	reached := map[string]bool{v: true}
+	stack := []string{v}
+	for len(stack) != 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, w := range g.next[u] {
+			if listed[w] && !reached[w] {
+				reached[w] = true
+				stack = append(stack, w)
+			}
+		}
+	}
+	return reached
+}
+
+// checkComponents compares components with brute force reachability in the undirected graph u.
+func checkComponents(t *testing.T, name string, trial int, g, u adjacency, component map[string]int, sizes []int) {
+	listed := make(map[string]bool)
+	for _, v := range g.vertices {
+		listed[v] = true
+	}
+	if len(component) != len(g.vertices) {
+		t.Errorf("%s, trial %d: %d labelled vertices instead of %d", name, trial, len(component), len(g.vertices))
+	}
+
+	next := 0 // next new component number
+	counts := make([]int, len(sizes))
+	for _, v := range g.vertices {
+		c := component[v]
+		if c > next || c < 0 || c >= len(sizes) {
+			t.Fatalf("%s, trial %d: wrong component number %d for %s", name, trial, c, v)
+		}
+		if c == next {
+			next++
+		}
+		counts[c]++
+
+		reached := reachableListed(u, v, listed)
+		for _, w := range g.vertices {
+			if (component[w] == c) != reached[w] {
+				t.Errorf("%s, trial %d: %s and %s in the same component should be %v", name, trial, v, w, reached[w])
+			}
+		}
+	}
+	for c := range sizes {
+		if sizes[c] != counts[c] {
+			t.Errorf("%s, trial %d: size of component %d is %d instead of %d", name, trial, c, sizes[c], counts[c])
+		}
+	}
+}
+
+// TestComponents compares connected and weakly connected components of random graphs
+// with brute force reachability.
+// A hidden vertex linked to all vertices checks that edges to unlisted vertices are ignored.
+func TestComponents(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 200; trial++ {
+		g := randomAdjacency(rnd, 1+rnd.Intn(12), 0.1, 1)
+		g.next["hidden"] = append([]string(nil), g.vertices...)
+		for _, v := range g.vertices {
+			if rnd.Intn(2) == 0 {
+				g.next[v] = append(g.next[v], "hidden")
+			}
+		}
+
+		// undirected version of g
+		u := adjacency{vertices: g.vertices, next: make(map[string][]string)}
+		for v, next := range g.next {
+			for _, w := range next {
+				u.next[v] = append(u.next[v], w)
+				u.next[w] = append(u.next[w], v)
+			}
+		}
+
+		component, sizes := ConnectedComponents(u)
+		checkComponents(t, "connected", trial, g, u, component, sizes)
+		component, sizes = WeaklyConnectedComponents(g)
+		checkComponents(t, "weakly connected", trial, g, u, component, sizes)
+	}
+}
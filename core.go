@@ -0,0 +1,80 @@
+package graph
+
+// CoreNumbers returns the core number of each vertex of an undirected graph:
+// the largest k such that the vertex belongs to the k-core,
+// the maximal subgraph whose vertices all have at least k neighbours in it.
+// Each edge must be listed in both directions.
+// Self-loops, parallel edges and edges leading to vertices which are not listed by Vertices are ignored.
+//
+// It is the algorithm of Batagelj and Zaversnik, running in O(V+E) time.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func CoreNumbers(g VertexListForward) map[string]int {
+	vertices, adj := indexSimpleAdjacency(g)
+	_, core := coreDecomposition(adj)
+	numbers := make(map[string]int, len(vertices))
+	for i, v := range vertices {
+		numbers[v] = core[i]
+	}
+	return numbers
+}
+
+// coreDecomposition computes the core number of each vertex of an undirected simple graph
+// given by the neighbours of each vertex,
+// and a degeneracy ordering: each vertex has at most its core number of neighbours after it.
+//
+// It is the bucket algorithm of Batagelj and Zaversnik, running in O(V+E) time:
+// vertices are removed by increasing degree, the degrees of their neighbours being decreased.
+func coreDecomposition(adj [][]int) (order, core []int) {
+	n := len(adj)
+	degree := make([]int, n)
+	maxDegree := 0
+	for v, next := range adj {
+		degree[v] = len(next)
+		if degree[v] > maxDegree {
+			maxDegree = degree[v]
+		}
+	}
+
+	// sort vertices by degree: bin[d] is the position of the first vertex of degree d
+	bin := make([]int, maxDegree+1)
+	for _, d := range degree {
+		bin[d]++
+	}
+	start := 0
+	for d, count := range bin {
+		bin[d] = start
+		start += count
+	}
+	order = make([]int, n)
+	pos := make([]int, n)
+	for v, d := range degree {
+		pos[v] = bin[d]
+		order[pos[v]] = v
+		bin[d]++
+	}
+	for d := maxDegree; d > 0; d-- {
+		bin[d] = bin[d-1]
+	}
+	bin[0] = 0
+
+	// remove vertices by increasing degree
+	for _, v := range order {
+		for _, u := range adj[v] {
+			if degree[u] > degree[v] {
+				// move u to the front of its bin and decrease its degree
+				du, pu := degree[u], pos[u]
+				pw := bin[du]
+				w := order[pw]
+				if u != w {
+					pos[u], pos[w] = pw, pu
+					order[pu], order[pw] = w, u
+				}
+				bin[du]++
+				degree[u]--
+			}
+		}
+	}
+	return order, degree
+}
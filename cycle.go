@@ -0,0 +1,223 @@
+package graph
+
+// FindCycle returns a cycle of a directed graph,
+// or nil if the graph is acyclic.
+// The cycle is the list of its vertices, the last one being linked to the first one.
+//
+// It runs a depth-first visit in linear time: a back edge closes a cycle.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func FindCycle(g VertexListForward) []string {
+	vis := &cycleVisitor{parent: make(map[string]string)}
+	DepthFirstVisit(g, vis)
+	return vis.cycle
+}
+
+// FindUndirectedCycle returns a cycle of an undirected graph,
+// or nil if the graph is a forest.
+// Each edge must be listed in both directions:
+// going back through the same edge is not a cycle but parallel edges are.
+// The cycle is the list of its vertices, the last one being linked to the first one.
+//
+// It runs a depth-first visit in linear time: a back edge closes a cycle.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func FindUndirectedCycle(g VertexListForward) []string {
+	vis := &cycleVisitor{
+		parent:     make(map[string]string),
+		undirected: true,
+		skipped:    make(map[string]bool),
+	}
+	DepthFirstVisit(g, vis)
+	return vis.cycle
+}
+
+// cycleVisitor is a DfsVisitor recording the first cycle found.
+type cycleVisitor struct {
+	parent map[string]string // parent in the search tree
+	cycle  []string
+
+	// on undirected graphs, the edge back to the parent is skipped once
+	undirected bool
+	skipped    map[string]bool
+}
+
+func (vis *cycleVisitor) InitializeVertex(string)         {}
+func (vis *cycleVisitor) DiscoverVertex(string)           {}
+func (vis *cycleVisitor) ExamineEdge(string, string)      {}
+func (vis *cycleVisitor) ForwardCrossEdge(string, string) {}
+func (vis *cycleVisitor) FinishVertex(string)             {}
+
+func (vis *cycleVisitor) TreeEdge(from, to string) { vis.parent[to] = from }
+
+func (vis *cycleVisitor) BackEdge(from, to string) {
+	if vis.cycle != nil {
+		return
+	}
+	if vis.undirected && from != to && vis.parent[from] == to && !vis.skipped[from] {
+		vis.skipped[from] = true
+		return
+	}
+
+	// to is an ancestor of from: walk up the tree from from to to
+	cycle := []string{from}
+	for v := from; v != to; {
+		v = vis.parent[v]
+		cycle = append(cycle, v)
+	}
+	reverseStrings(cycle)
+	vis.cycle = cycle
+}
+
+// ElementaryCircuits enumerates the elementary circuits of a directed graph,
+// i.e. its cycles which do not go twice through the same vertex.
+// Each circuit is passed to fn as the list of its vertices,
+// the last one being linked to the first one.
+// The slice is not used anymore once fn returns.
+// The enumeration stops if fn returns false.
+//
+// If maxLength is positive, only circuits with at most maxLength vertices are enumerated.
+//
+// It is Johnson's algorithm: circuits are searched from each vertex in the order of Vertices,
+// in the strongly connected component of the subgraph made of this vertex and the next ones.
+// It runs in O((V+E)(C+1)) time where C is the number of circuits.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func ElementaryCircuits(g VertexListForward, maxLength int, fn func(circuit []string) bool) {
+	vertices := g.Vertices()
+	for i, s := range vertices {
+		// strongly connected component of s in the subgraph of s and next vertices
+		sub := inducedSubgraph{g: g, vertices: vertices[i:], in: make(map[string]bool)}
+		for _, v := range sub.vertices {
+			sub.in[v] = true
+		}
+		var component []string
+		for _, c := range StronglyConnectedComponents(sub) {
+			for _, v := range c {
+				if v == s {
+					component = c
+				}
+			}
+		}
+
+		// search circuits through s in its component
+		j := &johnson{
+			s:         s,
+			maxLength: maxLength,
+			fn:        fn,
+			next:      make(map[string][]string, len(component)),
+			blocked:   make(map[string]bool, len(component)),
+			b:         make(map[string]map[string]bool, len(component)),
+		}
+		in := make(map[string]bool, len(component))
+		for _, v := range component {
+			in[v] = true
+		}
+		for _, v := range component {
+			seen := make(map[string]bool) // parallel edges would duplicate circuits
+			for _, w := range g.NextVertices(v) {
+				if in[w] && !seen[w] {
+					seen[w] = true
+					j.next[v] = append(j.next[v], w)
+				}
+			}
+			j.b[v] = make(map[string]bool)
+		}
+		j.circuit(s)
+		if j.stopped {
+			return
+		}
+	}
+}
+
+// johnson holds the state of Johnson's algorithm
+// while searching circuits through vertex s.
+type johnson struct {
+	s         string
+	maxLength int
+	fn        func(circuit []string) bool
+	stopped   bool
+
+	next    map[string][]string        // edges of the component of s
+	stack   []string                   // current path from s
+	blocked map[string]bool            // vertices which cannot lead back to s for now
+	b       map[string]map[string]bool // vertices to unblock when a vertex is unblocked
+}
+
+// circuit extends the current path with v and looks for circuits.
+// It returns true if a circuit has been found,
+// or if the search has been cut by the length limit.
+func (j *johnson) circuit(v string) bool {
+	found := false
+	j.stack = append(j.stack, v)
+	j.blocked[v] = true
+
+	for _, w := range j.next[v] {
+		if j.stopped {
+			break
+		}
+		if w == j.s {
+			circuit := make([]string, len(j.stack))
+			copy(circuit, j.stack)
+			j.stopped = !j.fn(circuit)
+			found = true
+		} else if j.maxLength > 0 && len(j.stack) >= j.maxLength {
+			// v may lead to s through longer paths: do not block it
+			found = true
+		} else if !j.blocked[w] && j.circuit(w) {
+			found = true
+		}
+	}
+
+	if found {
+		j.unblock(v)
+	} else {
+		for _, w := range j.next[v] {
+			j.b[w][v] = true
+		}
+	}
+	j.stack = j.stack[:len(j.stack)-1]
+	return found
+}
+
+// unblock unblocks u and recursively the vertices waiting for it.
+func (j *johnson) unblock(u string) {
+	j.blocked[u] = false
+	for w := range j.b[u] {
+		delete(j.b[u], w)
+		if j.blocked[w] {
+			j.unblock(w)
+		}
+	}
+}
+
+// inducedSubgraph is the subgraph of g made of the listed vertices
+// and of the edges between them.
+type inducedSubgraph struct {
+	g        VertexListForward
+	vertices []string
+	in       map[string]bool
+}
+
+func (sub inducedSubgraph) Vertices() []string { return sub.vertices }
+
+func (sub inducedSubgraph) NextVertices(v string) []string {
+	var next []string
+	for _, w := range sub.g.NextVertices(v) {
+		if sub.in[w] {
+			next = append(next, w)
+		}
+	}
+	return next
+}
+
+// reverseStrings reverses s in place.
+func reverseStrings(s []string) {
+	last := len(s) - 1
+	for i := 0; i < len(s)/2; i++ {
+		s[i], s[last-i] = s[last-i], s[i]
+	}
+}
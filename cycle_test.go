@@ -0,0 +1,155 @@
+package graph
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// bruteForceCircuits returns the keys of all elementary circuits of g with at most maxLength vertices,
+// starting from their smallest vertex in the order of Vertices.
+func bruteForceCircuits(g adjacency, maxLength int) map[string]bool {
+	circuits := make(map[string]bool)
+	position := make(map[string]int)
+	for i, v := range g.vertices {
+		position[v] = i
+	}
+
+	var extend func(path []string, onPath map[string]bool)
+	extend = func(path []string, onPath map[string]bool) {
+		v := path[len(path)-1]
+		for _, w := range g.next[v] {
+			if w == path[0] {
+				circuits[strings.Join(path, " ")] = true
+			} else if !onPath[w] && position[w] > position[path[0]] && (maxLength <= 0 || len(path) < maxLength) {
+				onPath[w] = true
+				extend(append(path, w), onPath)
+				onPath[w] = false
+			}
+		}
+	}
+	for _, s := range g.vertices {
+		extend([]string{s}, map[string]bool{s: true})
+	}
+	return circuits
+}
+
+// TestElementaryCircuits compares the circuits found on random directed graphs
+// with a brute force enumeration.
+func TestElementaryCircuits(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 200; trial++ {
+		g := randomAdjacency(rnd, 1+rnd.Intn(7), 0.3, 1)
+		if rnd.Intn(2) == 0 {
+			// add a self-loop
+			v := g.vertices[rnd.Intn(len(g.vertices))]
+			g.next[v] = append(g.next[v], v)
+		}
+
+		for _, maxLength := range []int{0, 1, 3} {
+			expected := bruteForceCircuits(g, maxLength)
+			found := make(map[string]bool)
+			ElementaryCircuits(g, maxLength, func(circuit []string) bool {
+				key := strings.Join(circuit, " ")
+				if found[key] {
+					t.Errorf("trial %d: circuit %v found twice", trial, circuit)
+				}
+				found[key] = true
+				return true
+			})
+
+			if len(found) != len(expected) {
+				t.Errorf("trial %d, max length %d: %d circuits instead of %d", trial, maxLength, len(found), len(expected))
+			}
+			for key := range found {
+				if !expected[key] {
+					t.Errorf("trial %d, max length %d: unexpected circuit %v", trial, maxLength, key)
+				}
+			}
+		}
+
+		// a cycle is found if and only if there are circuits
+		cycle := FindCycle(g)
+		if (cycle == nil) != (len(bruteForceCircuits(g, 0)) == 0) {
+			t.Errorf("trial %d: cycle %v", trial, cycle)
+		}
+		for i, v := range cycle {
+			found := false
+			for _, w := range g.next[v] {
+				found = found || w == cycle[(i+1)%len(cycle)]
+			}
+			if !found {
+				t.Errorf("trial %d: %v is not a cycle", trial, cycle)
+			}
+		}
+	}
+}
+
+// TestElementaryCircuitsStop checks that the enumeration stops when asked to.
+func TestElementaryCircuitsStop(t *testing.T) {
+	// complete digraph with 4 vertices has 20 circuits
+	g := adjacency{
+		vertices: []string{"A", "B", "C", "D"},
+		next: map[string][]string{
+			"A": []string{"B", "C", "D"},
+			"B": []string{"A", "C", "D"},
+			"C": []string{"A", "B", "D"},
+			"D": []string{"A", "B", "C"},
+		},
+	}
+
+	count := 0
+	ElementaryCircuits(g, 0, func([]string) bool {
+		count++
+		return count < 5
+	})
+	if count != 5 {
+		t.Errorf("%d circuits enumerated instead of 5", count)
+	}
+}
+
+// TestStronglyConnectedComponents compares the components of random directed graphs
+// with mutual reachability.
+func TestStronglyConnectedComponents(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 100; trial++ {
+		g := randomAdjacency(rnd, 1+rnd.Intn(10), 0.15, 1)
+
+		// reachability by brute force
+		reach := make(map[[2]string]bool)
+		for _, s := range g.vertices {
+			stack := []string{s}
+			reach[[2]string{s, s}] = true
+			for len(stack) > 0 {
+				v := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				for _, w := range g.next[v] {
+					if !reach[[2]string{s, w}] {
+						reach[[2]string{s, w}] = true
+						stack = append(stack, w)
+					}
+				}
+			}
+		}
+
+		component := make(map[string]int)
+		components := StronglyConnectedComponents(g)
+		for i, c := range components {
+			for _, v := range c {
+				component[v] = i
+			}
+		}
+		for _, v := range g.vertices {
+			for _, w := range g.vertices {
+				same := reach[[2]string{v, w}] && reach[[2]string{w, v}]
+				if same != (component[v] == component[w]) {
+					t.Errorf("trial %d: %s and %s in the same component: %v", trial, v, w, !same)
+				}
+				// reverse topological order
+				if reach[[2]string{v, w}] && component[v] < component[w] {
+					t.Errorf("trial %d: %s reaches %s from a previous component", trial, v, w)
+				}
+			}
+		}
+	}
+}
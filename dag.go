@@ -0,0 +1,233 @@
+package graph
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrCycle is returned by functions expecting a directed acyclic graph
+// when the graph has a cycle.
+var ErrCycle = errors.New("graph: the graph has a cycle")
+
+// toposortVisitor is a DfsVisitor computing a reverse topological order
+// and detecting cycles.
+type toposortVisitor struct {
+	order  []string // reverse topological order
+	cyclic bool     // true if a back edge has been found
+}
+
+func (vis *toposortVisitor) InitializeVertex(string)         {}
+func (vis *toposortVisitor) DiscoverVertex(string)           {}
+func (vis *toposortVisitor) ExamineEdge(string, string)      {}
+func (vis *toposortVisitor) TreeEdge(string, string)         {}
+func (vis *toposortVisitor) BackEdge(string, string)         { vis.cyclic = true }
+func (vis *toposortVisitor) ForwardCrossEdge(string, string) {}
+func (vis *toposortVisitor) FinishVertex(v string)           { vis.order = append(vis.order, v) }
+
+// TopologicalSort returns the vertices of g such that
+// each vertex comes before all vertices reachable from it.
+// It returns ErrCycle if g is not a directed acyclic graph.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func TopologicalSort(g VertexListForward) ([]string, error) {
+	vis := &toposortVisitor{}
+	DepthFirstVisit(g, vis)
+	if vis.cyclic {
+		return nil, ErrCycle
+	}
+
+	// reverse the order of finished vertices
+	order := vis.order
+	last := len(order) - 1
+	for i := 0; i < len(order)/2; i++ {
+		order[i], order[last-i] = order[last-i], order[i]
+	}
+	return order, nil
+}
+
+// PathTree holds the paths from a source vertex to the vertices reachable from it.
+type PathTree struct {
+	source      string
+	dist        map[string]float64 // length of the path from the source
+	pred        map[string]string  // predecessor on the path from the source
+	unreachable float64            // distance to unreachable vertices
+}
+
+// Source returns the source vertex of the paths.
+func (t *PathTree) Source() string { return t.source }
+
+// Distance returns the length of the path from the source to v.
+// For vertices which are not reachable from the source,
+// it returns +Inf for shortest paths and -Inf for longest paths.
+func (t *PathTree) Distance(v string) float64 {
+	d, ok := t.dist[v]
+	if !ok {
+		return t.unreachable
+	}
+	return d
+}
+
+// Path returns the vertices of the path from the source to v, both included.
+// It returns nil if v is not reachable from the source.
+func (t *PathTree) Path(v string) []string {
+	if _, ok := t.dist[v]; !ok {
+		return nil
+	}
+
+	// walk back to the source
+	path := []string{v}
+	for v != t.source {
+		v = t.pred[v]
+		path = append(path, v)
+	}
+
+	// reverse path
+	last := len(path) - 1
+	for i := 0; i < len(path)/2; i++ {
+		path[i], path[last-i] = path[last-i], path[i]
+	}
+	return path
+}
+
+// DAGShortestPaths computes the shortest paths from the source
+// to all vertices reachable from it in a directed acyclic graph.
+// Weights can be negative.
+// It returns ErrCycle if g is not a directed acyclic graph.
+//
+// It runs in linear time by relaxing the edges in topological order.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func DAGShortestPaths(g VertexListWeightForward, source string) (*PathTree, error) {
+	return dagPaths(g, source, math.Inf(1), func(d, current float64) bool { return d < current })
+}
+
+// DAGLongestPaths is similar to DAGShortestPaths but computes longest paths.
+func DAGLongestPaths(g VertexListWeightForward, source string) (*PathTree, error) {
+	return dagPaths(g, source, math.Inf(-1), func(d, current float64) bool { return d > current })
+}
+
+// dagPaths relaxes the edges of g in topological order.
+// Distance d replaces the current distance of a vertex if better(d, current) is true.
+func dagPaths(g VertexListWeightForward, source string, unreachable float64, better func(d, current float64) bool) (*PathTree, error) {
+	order, err := TopologicalSort(g)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &PathTree{
+		source:      source,
+		dist:        map[string]float64{source: 0},
+		pred:        make(map[string]string),
+		unreachable: unreachable,
+	}
+	for _, v := range order {
+		d, reached := t.dist[v]
+		if !reached {
+			continue // not reachable from the source (yet)
+		}
+		for _, w := range g.NextVertices(v) {
+			tentative := d + g.Weight(v, w)
+			if current, ok := t.dist[w]; !ok || better(tentative, current) {
+				t.dist[w] = tentative
+				t.pred[w] = v
+			}
+		}
+	}
+
+	return t, nil
+}
+
+// Schedule is the result of a critical path analysis.
+// Edges are activities whose durations are their weights
+// and vertices are events.
+type Schedule struct {
+	// Length is the duration of the whole project,
+	// i.e. the length of the longest path of the graph.
+	Length float64
+
+	// Path is a critical path: a longest path of the graph.
+	// Any delay on its edges delays the whole project.
+	Path []string
+
+	// Earliest is the earliest time of each event.
+	Earliest map[string]float64
+
+	// Latest is the latest time of each event which does not delay the project.
+	Latest map[string]float64
+
+	// Slack is the delay each event can suffer without delaying the project:
+	// Latest minus Earliest. It is zero for the vertices of the critical path.
+	Slack map[string]float64
+}
+
+// CriticalPath performs a critical path analysis of a directed acyclic graph.
+// Events without predecessors start at time zero.
+// Weights are durations and should be non-negative.
+// It returns ErrCycle if g is not a directed acyclic graph.
+//
+// It runs in linear time.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func CriticalPath(g VertexListWeightForward) (*Schedule, error) {
+	order, err := TopologicalSort(g)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Schedule{
+		Earliest: make(map[string]float64, len(order)),
+		Latest:   make(map[string]float64, len(order)),
+		Slack:    make(map[string]float64, len(order)),
+	}
+
+	// forward pass: earliest times are longest paths from any source
+	pred := make(map[string]string)
+	for _, v := range order {
+		s.Earliest[v] = 0 // events without predecessors start at zero
+	}
+	var end string // last event of the critical path
+	for _, v := range order {
+		// on ties, prefer the last event
+		if s.Earliest[v] >= s.Length {
+			s.Length = s.Earliest[v]
+			end = v
+		}
+		for _, w := range g.NextVertices(v) {
+			d := s.Earliest[v] + g.Weight(v, w)
+			if _, reached := pred[w]; !reached || d > s.Earliest[w] {
+				s.Earliest[w] = d
+				pred[w] = v
+			}
+		}
+	}
+
+	// backward pass: latest times are computed from the end of the project
+	for i := len(order) - 1; i >= 0; i-- {
+		v := order[i]
+		latest := s.Length
+		for _, w := range g.NextVertices(v) {
+			if l := s.Latest[w] - g.Weight(v, w); l < latest {
+				latest = l
+			}
+		}
+		s.Latest[v] = latest
+		s.Slack[v] = latest - s.Earliest[v]
+	}
+
+	// critical path is found walking back from the last event
+	if len(order) > 0 {
+		s.Path = []string{end}
+		for v, ok := pred[end]; ok; v, ok = pred[v] {
+			s.Path = append(s.Path, v)
+		}
+		last := len(s.Path) - 1
+		for i := 0; i < len(s.Path)/2; i++ {
+			s.Path[i], s.Path[last-i] = s.Path[last-i], s.Path[i]
+		}
+	}
+
+	return s, nil
+}
@@ -0,0 +1,225 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// randomDAG returns a random directed acyclic graph:
+// edges only go from a vertex to a vertex listed after it.
+// Weights are integers in [offset, offset+maxValue).
+func randomDAG(rnd *rand.Rand, n int, p float64, maxValue, offset int) adjacency {
+	g := randomAdjacency(rnd, n, p, maxValue)
+	for i, v := range g.vertices {
+		var next []string
+		for _, w := range g.next[v] {
+			for _, x := range g.vertices[i+1:] {
+				if w == x {
+					next = append(next, w)
+					g.weight[[2]string{v, w}] += float64(offset)
+				}
+			}
+		}
+		g.next[v] = next
+	}
+	return g
+}
+
+// pathWeights returns the weights of all paths from v to any vertex, v itself excluded.
+func pathWeights(g adjacency, v string, weights map[string][]float64, d float64) {
+	for _, w := range g.next[v] {
+		dw := d + g.Weight(v, w)
+		weights[w] = append(weights[w], dw)
+		pathWeights(g, w, weights, dw)
+	}
+}
+
+func TestTopologicalSort(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 100; trial++ {
+		g := randomDAG(rnd, 1+rnd.Intn(10), 0.3, 1, 0)
+		// shuffle the vertices so that the listing order is not a topological order
+		rnd.Shuffle(len(g.vertices), func(i, j int) { g.vertices[i], g.vertices[j] = g.vertices[j], g.vertices[i] })
+
+		order, err := TopologicalSort(g)
+		if err != nil {
+			t.Fatalf("trial %d: unexpected error %v", trial, err)
+		}
+		if len(order) != len(g.vertices) {
+			t.Errorf("trial %d: %d vertices instead of %d", trial, len(order), len(g.vertices))
+		}
+		position := make(map[string]int)
+		for i, v := range order {
+			position[v] = i
+		}
+		for _, v := range g.vertices {
+			for _, w := range g.next[v] {
+				if position[v] >= position[w] {
+					t.Errorf("trial %d: %s is not before %s", trial, v, w)
+				}
+			}
+		}
+	}
+}
+
+func TestDAGPaths(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 100; trial++ {
+		// negative weights are allowed
+		g := randomDAG(rnd, 1+rnd.Intn(8), 0.4, 10, -5)
+		source := g.vertices[rnd.Intn(len(g.vertices))]
+
+		weights := make(map[string][]float64)
+		pathWeights(g, source, weights, 0)
+		weights[source] = append(weights[source], 0)
+
+		shortest, err := DAGShortestPaths(g, source)
+		if err != nil {
+			t.Fatalf("trial %d: unexpected error %v", trial, err)
+		}
+		longest, err := DAGLongestPaths(g, source)
+		if err != nil {
+			t.Fatalf("trial %d: unexpected error %v", trial, err)
+		}
+
+		for _, v := range g.vertices {
+			min, max := math.Inf(1), math.Inf(-1)
+			for _, d := range weights[v] {
+				min = math.Min(min, d)
+				max = math.Max(max, d)
+			}
+			for _, tc := range []struct {
+				name     string
+				tree     *PathTree
+				expected float64
+			}{
+				{"shortest", shortest, min},
+				{"longest", longest, max},
+			} {
+				if d := tc.tree.Distance(v); d != tc.expected {
+					t.Errorf("trial %d: %s distance to %s is %v instead of %v", trial, tc.name, v, d, tc.expected)
+				}
+				path := tc.tree.Path(v)
+				if len(weights[v]) == 0 {
+					if path != nil {
+						t.Errorf("trial %d: unexpected %s path %v to unreachable %s", trial, tc.name, path, v)
+					}
+					continue
+				}
+				if path[0] != source || path[len(path)-1] != v {
+					t.Errorf("trial %d: %s path %v does not go from %s to %s", trial, tc.name, path, source, v)
+				}
+				d := 0.0
+				for i := 1; i < len(path); i++ {
+					d += g.Weight(path[i-1], path[i])
+				}
+				if d != tc.expected {
+					t.Errorf("trial %d: %s path %v has weight %v instead of %v", trial, tc.name, path, d, tc.expected)
+				}
+			}
+		}
+	}
+}
+
+func TestCriticalPath(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 100; trial++ {
+		// zero durations are allowed
+		g := randomDAG(rnd, 1+rnd.Intn(8), 0.4, 4, 0)
+
+		// earliest time: longest path ending at each event
+		// tail: longest path starting at each event
+		earliest := make(map[string]float64)
+		tail := make(map[string]float64)
+		for _, v := range g.vertices {
+			weights := make(map[string][]float64)
+			pathWeights(g, v, weights, 0)
+			for w, ds := range weights {
+				for _, d := range ds {
+					earliest[w] = math.Max(earliest[w], d)
+					tail[v] = math.Max(tail[v], d)
+				}
+			}
+		}
+		length := 0.0
+		for _, v := range g.vertices {
+			length = math.Max(length, earliest[v]+tail[v])
+		}
+
+		s, err := CriticalPath(g)
+		if err != nil {
+			t.Fatalf("trial %d: unexpected error %v", trial, err)
+		}
+		if s.Length != length {
+			t.Errorf("trial %d: length is %v instead of %v", trial, s.Length, length)
+		}
+		for _, v := range g.vertices {
+			if s.Earliest[v] != earliest[v] {
+				t.Errorf("trial %d: earliest time of %s is %v instead of %v", trial, v, s.Earliest[v], earliest[v])
+			}
+			if s.Latest[v] != length-tail[v] {
+				t.Errorf("trial %d: latest time of %s is %v instead of %v", trial, v, s.Latest[v], length-tail[v])
+			}
+			if s.Slack[v] != s.Latest[v]-s.Earliest[v] {
+				t.Errorf("trial %d: wrong slack of %s", trial, v)
+			}
+		}
+
+		// the critical path is a path of the graph with the project length and no slack
+		d := 0.0
+		for i, v := range s.Path {
+			if s.Slack[v] != 0 {
+				t.Errorf("trial %d: %s on the critical path has slack %v", trial, v, s.Slack[v])
+			}
+			if i == 0 {
+				continue
+			}
+			found := false
+			for _, w := range g.next[s.Path[i-1]] {
+				found = found || w == v
+			}
+			if !found {
+				t.Errorf("trial %d: critical path %v is not a path", trial, s.Path)
+			}
+			d += g.Weight(s.Path[i-1], v)
+		}
+		if d != length {
+			t.Errorf("trial %d: critical path %v has length %v instead of %v", trial, s.Path, d, length)
+		}
+	}
+
+	// a zero duration activity out of the start event is on the critical path
+	g := adjacency{
+		vertices: []string{"start", "a", "b"},
+		next:     map[string][]string{"start": {"a"}, "a": {"b"}},
+		weight:   map[[2]string]float64{{"start", "a"}: 0, {"a", "b"}: 5},
+	}
+	s, err := CriticalPath(g)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(s.Path) != 3 || s.Path[0] != "start" {
+		t.Errorf("critical path is %v instead of [start a b]", s.Path)
+	}
+}
+
+func TestDAGCycle(t *testing.T) {
+	g := adjacency{
+		vertices: []string{"a", "b", "c"},
+		next:     map[string][]string{"a": {"b"}, "b": {"c"}, "c": {"a"}},
+		weight:   map[[2]string]float64{{"a", "b"}: 1, {"b", "c"}: 1, {"c", "a"}: 1},
+	}
+	if _, err := TopologicalSort(g); err != ErrCycle {
+		t.Errorf("TopologicalSort: expected ErrCycle, got %v", err)
+	}
+	if _, err := DAGShortestPaths(g, "a"); err != ErrCycle {
+		t.Errorf("DAGShortestPaths: expected ErrCycle, got %v", err)
+	}
+	if _, err := DAGLongestPaths(g, "a"); err != ErrCycle {
+		t.Errorf("DAGLongestPaths: expected ErrCycle, got %v", err)
+	}
+	if _, err := CriticalPath(g); err != ErrCycle {
+		t.Errorf("CriticalPath: expected ErrCycle, got %v", err)
+	}
+}
@@ -29,6 +29,33 @@ type DfsVisitor interface {
 	FinishVertex(v string)
 }
 
+// DfsEdgeVisitor is the visitor to be passed to DepthFirstVisitEdges graph traversal function.
+// It is similar to DfsVisitor except that edge events receive the edge itself.
+type DfsEdgeVisitor interface {
+	// InitializeVertex is called for each vertex before the visit starts.
+	// It is called only for DepthFirstVisitEdges.
+	InitializeVertex(v string)
+
+	// DiscoverVertex is called when a new vertex is found.
+	DiscoverVertex(v string)
+
+	// ExamineEdge is called when navigating through the edge.
+	ExamineEdge(e Edge)
+
+	// TreeEdge is called when navigating to a new vertex.
+	TreeEdge(e Edge)
+
+	// BackEdge is called when a visited but unfinished vertex is found.
+	BackEdge(e Edge)
+
+	// ForwardCrossEdge is called when a finished vertex is found.
+	ForwardCrossEdge(e Edge)
+
+	// FinishVertex is called when all out edges have been added to the search tree
+	// and all corresponding adjacent vertices are finished.
+	FinishVertex(v string)
+}
+
 // DepthFirstVisitFrom performs a depth-first-search from the source vertex.
 // When possible, it chooses a vertex adjacent to the current vertex to visit next.
 // Otherwise it backtracks to the last vertex with unvisited adjacent vertices.
@@ -41,27 +68,115 @@ func DepthFirstVisitFrom(g Forward, vis DfsVisitor, source string) {
 	// init color map
 	cmap := make(map[string]color)
 
+	a := &dfsEdgeAdapter{DfsVisitor: vis, g: g}
+	depthFirstVisitFrom(a, a, cmap, source)
+}
+
+// DepthFirstVisit is similar to DepthFirstVisitFrom but it visits the whole graph.
+// It needs a graph whose vertices can be listed.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func DepthFirstVisit(g VertexListForward, vis DfsVisitor) {
+	a := &dfsEdgeAdapter{DfsVisitor: vis, g: g}
+	depthFirstVisit(a, a, g.Vertices())
+}
+
+// DepthFirstVisitFromEdges is similar to DepthFirstVisitFrom
+// except that it navigates through the edges of g
+// and that the visitor receives edges.
+//
+// The slice returned by calls to OutEdges is never modified.
+//
+// Parallel edges are all examined: the first one leading to a new vertex is a tree edge,
+// the other ones lead to an already discovered vertex.
+func DepthFirstVisitFromEdges(g EdgeForward, vis DfsEdgeVisitor, source string) {
+	// init color map
+	cmap := make(map[string]color)
+
 	depthFirstVisitFrom(g, vis, cmap, source)
 }
 
+// DepthFirstVisitEdges is similar to DepthFirstVisit
+// except that it navigates through the edges of g
+// and that the visitor receives edges.
+//
+// The slices returned by calls to OutEdges and Vertices are never modified.
+func DepthFirstVisitEdges(g VertexListEdgeForward, vis DfsEdgeVisitor) {
+	depthFirstVisit(g, vis, g.Vertices())
+}
+
+// dfsEdgeAdapter turns a Forward graph into an EdgeForward graph
+// and a DfsVisitor into a DfsEdgeVisitor.
+// The out edges of the vertices being visited are stacked in a shared buffer
+// and released when their vertex is finished,
+// so that visiting a Forward graph does not allocate a slice per vertex.
+type dfsEdgeAdapter struct {
+	DfsVisitor
+	g      Forward
+	edges  []Edge
+	frames []int // start of the out edges of each unfinished vertex
+}
+
+func (a *dfsEdgeAdapter) OutEdges(v string) []Edge {
+	start := len(a.edges)
+	a.frames = append(a.frames, start)
+	for _, w := range a.g.NextVertices(v) {
+		a.edges = append(a.edges, Edge{From: v, To: w})
+	}
+	return a.edges[start:]
+}
+
+func (a *dfsEdgeAdapter) ExamineEdge(e Edge)      { a.DfsVisitor.ExamineEdge(e.From, e.To) }
+func (a *dfsEdgeAdapter) TreeEdge(e Edge)         { a.DfsVisitor.TreeEdge(e.From, e.To) }
+func (a *dfsEdgeAdapter) BackEdge(e Edge)         { a.DfsVisitor.BackEdge(e.From, e.To) }
+func (a *dfsEdgeAdapter) ForwardCrossEdge(e Edge) { a.DfsVisitor.ForwardCrossEdge(e.From, e.To) }
+
+func (a *dfsEdgeAdapter) FinishVertex(v string) {
+	a.DfsVisitor.FinishVertex(v)
+	// the out edges of v are not used anymore
+	last := len(a.frames) - 1
+	a.edges = a.edges[:a.frames[last]]
+	a.frames = a.frames[:last]
+}
+
+// depthFirstVisit initializes the vertices and visits the whole graph.
+func depthFirstVisit(g EdgeForward, vis DfsEdgeVisitor, vertices []string) {
+	// visit vertices and init them
+	for _, v := range vertices {
+		vis.InitializeVertex(v)
+	}
+
+	// init color map
+	cmap := make(map[string]color)
+	// visit vertices and start a depth-first-visit from each one of them
+	for _, v := range vertices {
+		if cmap[v] == white {
+			depthFirstVisitFrom(g, vis, cmap, v)
+		}
+
+	}
+}
+
 // depthFirstVisitFrom recursively visits g.
-func depthFirstVisitFrom(g Forward, vis DfsVisitor, cmap map[string]color, source string) {
+func depthFirstVisitFrom(g EdgeForward, vis DfsEdgeVisitor, cmap map[string]color, source string) {
 	// Discover the source vertex and turn it to gray
 	vis.DiscoverVertex(source)
 	cmap[source] = gray
 
 	// visit out edges and adjacent vertices
-	for _, next := range g.NextVertices(source) {
-		vis.ExamineEdge(source, next)
+	for _, e := range g.OutEdges(source) {
+		next := e.To
+		vis.ExamineEdge(e)
 
 		switch cmap[next] {
 		case white:
-			vis.TreeEdge(source, next)
+			vis.TreeEdge(e)
 			depthFirstVisitFrom(g, vis, cmap, next)
 		case gray:
-			vis.BackEdge(source, next)
+			vis.BackEdge(e)
 		case black:
-			vis.ForwardCrossEdge(source, next)
+			vis.ForwardCrossEdge(e)
 		}
 	}
 
@@ -70,25 +185,3 @@ func depthFirstVisitFrom(g Forward, vis DfsVisitor, cmap map[string]color, sourc
 	vis.FinishVertex(source)
 	cmap[source] = black
 }
-
-// DepthFirstVisit is similar to DepthFirstVisitFrom but it visits the whole graph.
-// It needs a graph whose vertices can be listed.
-//
-// The slices returned by calls to NextVertices and Vertices are never modified.
-// So there is no risk of accidentally modifying g.
-func DepthFirstVisit(g VertexListForward, vis DfsVisitor) {
-	// visit vertices and init them
-	for _, v := range g.Vertices() {
-		vis.InitializeVertex(v)
-	}
-
-	// init color map
-	cmap := make(map[string]color)
-	// visit vertices and start a depth-first-visit from each one of them
-	for _, v := range g.Vertices() {
-		if cmap[v] == white {
-			depthFirstVisitFrom(g, vis, cmap, v)
-		}
-
-	}
-}
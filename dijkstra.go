@@ -22,6 +22,29 @@ type DijkstraVisitor interface {
 	FinishVertex(v string)
 }
 
+// DijkstraEdgeVisitor is the visitor to be passed to DijkstraEdges functions.
+// It is similar to DijkstraVisitor except that edge events receive the edge itself.
+type DijkstraEdgeVisitor interface {
+	// DiscoverVertex is called when a new vertex is found.
+	DiscoverVertex(v string)
+
+	// ExamineVertex is called when a vertex is dequeued.
+	ExamineVertex(v string)
+
+	// ExamineEdge is called when navigating through the edge.
+	ExamineEdge(e Edge)
+
+	// EdgeRelaxed is called when a shorter path to vertex e.To is found
+	// or if it was just discovered.
+	EdgeRelaxed(e Edge)
+
+	// EdgeNotRelaxed is called when a longer path to vertex e.To is found.
+	EdgeNotRelaxed(e Edge)
+
+	// FinishVertex is called when a vertex has been examined.
+	FinishVertex(v string)
+}
+
 // Dijkstra visits the graph in Dijkstra order, i.e. closest vertices first.
 // It stops when all vertices reachable from the source have been visited.
 //
@@ -34,16 +57,59 @@ type DijkstraVisitor interface {
 //
 // If all weights are equal to one, use breadth-first-search with the appropriate visitor instead.
 func Dijkstra(g WeightForward, vis DijkstraVisitor, source string) {
-	dijkstra(g, vis, source, nil)
+	dijkstra(&forwardEdgeBuffer{g: g}, weightOf(g), dijkstraEdgeAdapter{vis}, source, nil)
 }
 
 // DijkstraTo is similar to Dijkstra except that it stops when the target vertex has been reached.
 // If the target vertex is not-reachable from the source, it behaves exactly as Dijkstra.
 func DijkstraTo(g WeightForward, vis DijkstraVisitor, source, target string) {
-	dijkstra(g, vis, source, &target)
+	dijkstra(&forwardEdgeBuffer{g: g}, weightOf(g), dijkstraEdgeAdapter{vis}, source, &target)
+}
+
+// DijkstraEdges is similar to Dijkstra
+// except that it navigates through the edges of g
+// and that the visitor receives edges.
+// When several edges link the same pair of vertices, the lightest one is relaxed.
+//
+// The slice returned by calls to OutEdges is never modified.
+func DijkstraEdges(g EdgeForward, vis DijkstraEdgeVisitor, source string) {
+	dijkstra(g, edgeWeight, vis, source, nil)
+}
+
+// DijkstraEdgesTo is similar to DijkstraEdges except that it stops when the target vertex has been reached.
+// If the target vertex is not-reachable from the source, it behaves exactly as DijkstraEdges.
+func DijkstraEdgesTo(g EdgeForward, vis DijkstraEdgeVisitor, source, target string) {
+	dijkstra(g, edgeWeight, vis, source, &target)
+}
+
+// dijkstraEdgeAdapter turns a DijkstraVisitor into a DijkstraEdgeVisitor.
+type dijkstraEdgeAdapter struct {
+	DijkstraVisitor
+}
+
+func (vis dijkstraEdgeAdapter) ExamineEdge(e Edge) {
+	vis.DijkstraVisitor.ExamineEdge(e.From, e.To)
 }
 
-func dijkstra(g WeightForward, vis DijkstraVisitor, source string, target *string) {
+func (vis dijkstraEdgeAdapter) EdgeRelaxed(e Edge) {
+	vis.DijkstraVisitor.EdgeRelaxed(e.From, e.To)
+}
+
+func (vis dijkstraEdgeAdapter) EdgeNotRelaxed(e Edge) {
+	vis.DijkstraVisitor.EdgeNotRelaxed(e.From, e.To)
+}
+
+// edgeWeight returns the weight carried by e.
+func edgeWeight(e Edge) float64 { return e.Weight }
+
+// weightOf returns the weight of edges as given by g.
+func weightOf(g WeightForward) func(Edge) float64 {
+	return func(e Edge) float64 { return g.Weight(e.From, e.To) }
+}
+
+// dijkstra visits g in Dijkstra order.
+// Weights are asked only for edges leading to unfinished vertices.
+func dijkstra(g EdgeForward, weight func(Edge) float64, vis DijkstraEdgeVisitor, source string, target *string) {
 	// init queue, color map and distance map
 	cmap := make(map[string]color)
 	queue := newPriorityQueue()
@@ -68,18 +134,19 @@ func dijkstra(g WeightForward, vis DijkstraVisitor, source string, target *strin
 		}
 
 		// visit neighbours
-		for _, next := range g.NextVertices(v) {
-			vis.ExamineEdge(v, next)
+		for _, e := range g.OutEdges(v) {
+			next := e.To
+			vis.ExamineEdge(e)
 
 			// if already visited, ignore it
 			if cmap[next] == black {
 				continue
 			}
 
-			tentative := d + g.Weight(v, next)
+			tentative := d + weight(e)
 			if tentative < queue.distance(next) {
 				// a shorter path to next has been found
-				vis.EdgeRelaxed(v, next)
+				vis.EdgeRelaxed(e)
 				if cmap[next] == white {
 					vis.DiscoverVertex(next)
 					queue.push(next, tentative)
@@ -89,7 +156,7 @@ func dijkstra(g WeightForward, vis DijkstraVisitor, source string, target *strin
 				}
 			} else {
 				// found a longer path to next
-				vis.EdgeNotRelaxed(v, next)
+				vis.EdgeNotRelaxed(e)
 			}
 		}
 
@@ -99,3 +166,54 @@ func dijkstra(g WeightForward, vis DijkstraVisitor, source string, target *strin
 
 	return
 }
+
+// shortestPathTree is a DijkstraEdgeVisitor recording distances from the source
+// and the edges of the shortest path tree.
+type shortestPathTree struct {
+	source string
+	dist   map[string]float64 // distance from the source
+	pred   map[string]Edge    // edge leading to a vertex in the tree
+}
+
+func newShortestPathTree(source string) *shortestPathTree {
+	return &shortestPathTree{
+		source: source,
+		dist:   map[string]float64{source: 0},
+		pred:   make(map[string]Edge),
+	}
+}
+
+func (t *shortestPathTree) DiscoverVertex(string) {}
+func (t *shortestPathTree) ExamineVertex(string)  {}
+func (t *shortestPathTree) ExamineEdge(Edge)      {}
+func (t *shortestPathTree) EdgeNotRelaxed(Edge)   {}
+func (t *shortestPathTree) FinishVertex(string)   {}
+
+func (t *shortestPathTree) EdgeRelaxed(e Edge) {
+	t.dist[e.To] = t.dist[e.From] + e.Weight
+	t.pred[e.To] = e
+}
+
+// path returns the path from the source to the target.
+// It returns false if the target has not been reached.
+func (t *shortestPathTree) path(target string) (Path, bool) {
+	d, found := t.dist[target]
+	if !found {
+		return Path{}, false
+	}
+
+	// walk back to the source
+	vertices := []string{target}
+	for v := target; v != t.source; {
+		v = t.pred[v].From
+		vertices = append(vertices, v)
+	}
+
+	// reverse path
+	last := len(vertices) - 1
+	for i := 0; i < len(vertices)/2; i++ {
+		vertices[i], vertices[last-i] = vertices[last-i], vertices[i]
+	}
+
+	return Path{Vertices: vertices, Weight: d}, true
+}
@@ -5,14 +5,69 @@ Visit:
 
   - breadth-first visit
   - depth-first visit
+  - topological sort
+  - lowest common ancestors in search trees
+
+Connectivity:
+
+  - connected and weakly connected components
+  - bipartiteness test
+  - strongly connected components
+  - articulation points, bridges and biconnected components
+  - transitive closure and transitive reduction
+  - dominator and post-dominator trees, dominance frontiers
 
 Shortest distance:
 
   - Dijkstra
+  - Floyd-Warshall all pairs
+  - Yen k shortest loopless paths
+  - shortest and longest paths in directed acyclic graphs, critical path
   - A* (TODO)
   - Bellman-Ford (TODO)
   - Johnson all pairs (TODO)
 
+Flows:
+
+  - Edmonds-Karp, Dinic and push-relabel maximum flow
+  - minimum s-t cut
+  - minimum cost flow
+
+Matching:
+
+  - Hopcroft-Karp maximum bipartite matching and minimum vertex cover
+  - Hungarian (Kuhn-Munkres) assignment
+  - Edmonds blossom maximum cardinality and maximum weight matching
+
+Centrality:
+
+  - PageRank and personalised PageRank
+  - betweenness, closeness and harmonic centralities
+  - eigenvector and Katz centralities, HITS hubs and authorities
+
+Cohesion:
+
+  - core numbers (k-core decomposition)
+  - triangle counts
+  - local and global clustering coefficients
+
+Communities:
+
+  - Louvain modularity optimisation
+  - label propagation
+  - modularity
+
+Cliques:
+
+  - Bron-Kerbosch maximal cliques with pivoting and degeneracy ordering
+  - maximum clique
+
+Coloring:
+
+  - greedy coloring with largest-first and smallest-last orders
+  - DSatur
+  - coloring validation
+
 Minimum Spanning Tree:
 
   - Kruskal (TODO)
@@ -20,6 +75,8 @@ Minimum Spanning Tree:
 
 Cycles and Circuits:
 
+  - cycle detection
+  - Johnson elementary circuits
   - Euler cycle (TODO)
 */
 package graph
@@ -0,0 +1,189 @@
+package graph
+
+// DominatorTree holds the dominance relation of the vertices reachable from an entry vertex.
+// A vertex u dominates a vertex v if every path from the entry to v goes through u.
+//
+// The dominator tree is a Forward graph itself:
+// the next vertices of a vertex are the vertices it immediately dominates.
+type DominatorTree struct {
+	// Entry is the root of the tree.
+	Entry string
+
+	// Idom is the immediate dominator of each reachable vertex, except the entry:
+	// the closest strict dominator of the vertex.
+	Idom map[string]string
+
+	// Frontier is the dominance frontier of each reachable vertex:
+	// the vertices w such that the vertex dominates a predecessor of w but does not strictly dominate w.
+	// Vertices with an empty frontier are not in the map.
+	Frontier map[string][]string
+
+	children  map[string][]string
+	pre, post map[string]int // depth-first numbering of the tree
+}
+
+// Dominators computes the dominator tree of the vertices reachable from the entry vertex.
+//
+// It is the iterative algorithm of Cooper, Harvey and Kennedy:
+// immediate dominators are refined in reverse postorder until a fixed point is reached.
+// Dominance frontiers are then computed walking up the tree from the predecessors of each vertex.
+//
+// The slice returned by calls to NextVertices is never modified.
+// So there is no risk of accidentally modifying g.
+func Dominators(g Forward, entry string) *DominatorTree {
+	// postorder and predecessors of the reachable vertices
+	vis := &predecessorVisitor{
+		postorder: make(map[string]int),
+		pred:      make(map[string][]string),
+	}
+	DepthFirstVisitFrom(g, vis, entry)
+	order := vis.order
+	reverseStrings(order)
+
+	// immediate dominators, the entry is its own dominator during the computation
+	idom := map[string]string{entry: entry}
+	intersect := func(u, v string) string {
+		for u != v {
+			for vis.postorder[u] < vis.postorder[v] {
+				u = idom[u]
+			}
+			for vis.postorder[v] < vis.postorder[u] {
+				v = idom[v]
+			}
+		}
+		return u
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, v := range order[1:] {
+			var newIdom string
+			found := false
+			for _, p := range vis.pred[v] {
+				if _, processed := idom[p]; !processed {
+					continue
+				}
+				if !found {
+					newIdom = p
+					found = true
+				} else {
+					newIdom = intersect(p, newIdom)
+				}
+			}
+			if idom[v] != newIdom {
+				idom[v] = newIdom
+				changed = true
+			}
+		}
+	}
+	delete(idom, entry)
+
+	t := &DominatorTree{
+		Entry:    entry,
+		Idom:     idom,
+		Frontier: make(map[string][]string),
+		children: make(map[string][]string),
+		pre:      make(map[string]int),
+		post:     make(map[string]int),
+	}
+	for _, v := range order[1:] {
+		t.children[idom[v]] = append(t.children[idom[v]], v)
+	}
+
+	// dominance frontiers
+	inFrontier := make(map[[2]string]bool)
+	for _, v := range order {
+		for _, p := range vis.pred[v] {
+			// walk up from p to the immediate dominator of v, excluded
+			// the entry has no immediate dominator: walk up to the root, included
+			for runner := p; ; runner = idom[runner] {
+				if d, found := idom[v]; found && runner == d {
+					break
+				}
+				if !inFrontier[[2]string{runner, v}] {
+					inFrontier[[2]string{runner, v}] = true
+					t.Frontier[runner] = append(t.Frontier[runner], v)
+				}
+				if runner == entry {
+					break
+				}
+			}
+		}
+	}
+
+	// number the tree to answer dominance queries
+	DepthFirstVisitFrom(t, &intervalVisitor{pre: t.pre, post: t.post}, entry)
+
+	return t
+}
+
+// PostDominators computes the post-dominator tree of the vertices which can reach the exit vertex.
+// A vertex u post-dominates a vertex v if every path from v to the exit goes through u.
+//
+// It computes the dominator tree of the reverse graph from the exit vertex.
+// Edges leading to vertices which are not listed by Vertices are ignored.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func PostDominators(g VertexListForward, exit string) *DominatorTree {
+	return Dominators(reverseGraph(g), exit)
+}
+
+// NextVertices returns the vertices immediately dominated by v.
+func (t *DominatorTree) NextVertices(v string) []string { return t.children[v] }
+
+// Dominates returns true if u dominates v.
+// Every reachable vertex dominates itself.
+func (t *DominatorTree) Dominates(u, v string) bool {
+	preU, okU := t.pre[u]
+	preV, okV := t.pre[v]
+	if !okU || !okV {
+		return false
+	}
+	return preU <= preV && t.post[v] <= t.post[u]
+}
+
+// predecessorVisitor is a DfsVisitor recording the postorder of the vertices
+// and the predecessors of each vertex.
+type predecessorVisitor struct {
+	order     []string            // vertices in postorder
+	postorder map[string]int      // position of each vertex in order
+	pred      map[string][]string // predecessors of each vertex
+}
+
+func (vis *predecessorVisitor) InitializeVertex(string)         {}
+func (vis *predecessorVisitor) DiscoverVertex(string)           {}
+func (vis *predecessorVisitor) TreeEdge(string, string)         {}
+func (vis *predecessorVisitor) BackEdge(string, string)         {}
+func (vis *predecessorVisitor) ForwardCrossEdge(string, string) {}
+
+func (vis *predecessorVisitor) ExamineEdge(from, to string) {
+	vis.pred[to] = append(vis.pred[to], from)
+}
+
+func (vis *predecessorVisitor) FinishVertex(v string) {
+	vis.postorder[v] = len(vis.order)
+	vis.order = append(vis.order, v)
+}
+
+// intervalVisitor is a DfsVisitor numbering vertices when they are discovered and finished.
+// On a tree, u is an ancestor of v if and only if the interval of u contains the interval of v.
+type intervalVisitor struct {
+	time      int
+	pre, post map[string]int
+}
+
+func (vis *intervalVisitor) InitializeVertex(string)         {}
+func (vis *intervalVisitor) ExamineEdge(string, string)      {}
+func (vis *intervalVisitor) TreeEdge(string, string)         {}
+func (vis *intervalVisitor) BackEdge(string, string)         {}
+func (vis *intervalVisitor) ForwardCrossEdge(string, string) {}
+
+func (vis *intervalVisitor) DiscoverVertex(v string) {
+	vis.pre[v] = vis.time
+	vis.time++
+}
+
+func (vis *intervalVisitor) FinishVertex(v string) {
+	vis.post[v] = vis.time
+	vis.time++
+}
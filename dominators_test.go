@@ -0,0 +1,99 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// reachableAvoiding returns the vertices reachable from the source without going through the avoided vertex.
+func reachableAvoiding(g adjacency, source, avoided string) map[string]bool {
+	reached := map[string]bool{source: true}
+	stack := []string{source}
+	for len(stack) != 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, w := range g.next[v] {
+			if w != avoided && !reached[w] {
+				reached[w] = true
+				stack = append(stack, w)
+			}
+		}
+	}
+	return reached
+}
+
+// TestDominators compares the dominator tree of random directed graphs
+// with the definition of dominance.
+func TestDominators(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 300; trial++ {
+		g := randomAdjacency(rnd, 1+rnd.Intn(9), 0.25, 1)
+		entry := g.vertices[0]
+		tree := Dominators(g, entry)
+
+		// dominates[u][v] is true if u dominates v
+		reachable := reachableAvoiding(g, entry, "")
+		dominates := make(map[string]map[string]bool)
+		for _, u := range g.vertices {
+			dominates[u] = make(map[string]bool)
+			if !reachable[u] {
+				continue
+			}
+			avoiding := reachableAvoiding(g, entry, u)
+			for _, v := range g.vertices {
+				dominates[u][v] = reachable[v] && (u == entry || u == v || !avoiding[v])
+			}
+		}
+
+		for _, u := range g.vertices {
+			for _, v := range g.vertices {
+				if tree.Dominates(u, v) != dominates[u][v] {
+					t.Errorf("trial %d: dominates(%s, %s) should be %v", trial, u, v, dominates[u][v])
+				}
+			}
+		}
+
+		for _, v := range g.vertices {
+			idom, found := tree.Idom[v]
+			if found != (reachable[v] && v != entry) {
+				t.Errorf("trial %d: unexpected immediate dominator of %s", trial, v)
+				continue
+			}
+			if !found {
+				continue
+			}
+			// the immediate dominator is a strict dominator dominated by all strict dominators
+			if !dominates[idom][v] || idom == v {
+				t.Errorf("trial %d: %s does not strictly dominate %s", trial, idom, v)
+			}
+			for _, u := range g.vertices {
+				if u != v && dominates[u][v] && !dominates[u][idom] {
+					t.Errorf("trial %d: %s is not the immediate dominator of %s", trial, idom, v)
+				}
+			}
+		}
+
+		for _, u := range g.vertices {
+			inFrontier := make(map[string]bool)
+			for _, w := range tree.Frontier[u] {
+				if inFrontier[w] {
+					t.Errorf("trial %d: %s twice in the frontier of %s", trial, w, u)
+				}
+				inFrontier[w] = true
+			}
+			for _, w := range g.vertices {
+				expected := false
+				for _, p := range g.vertices {
+					for _, x := range g.next[p] {
+						if x == w && dominates[u][p] && (u == w || !dominates[u][w]) {
+							expected = true
+						}
+					}
+				}
+				if inFrontier[w] != expected {
+					t.Errorf("trial %d: %s in the frontier of %s should be %v", trial, w, u, expected)
+				}
+			}
+		}
+	}
+}
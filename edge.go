@@ -0,0 +1,69 @@
+package graph
+
+// Edge is an edge of a graph going from vertex From to vertex To.
+//
+// Several edges may link the same pair of vertices:
+// they can be told apart thanks to their weight and label.
+type Edge struct {
+	From, To string
+
+	// Weight is the weight of the edge.
+	Weight float64
+
+	// Label is an optional name identifying the edge among parallel edges.
+	Label string
+}
+
+// ForwardEdges adapts a Forward graph to the EdgeForward interface.
+// Edges have weight one and no label.
+func ForwardEdges(g Forward) EdgeForward { return forwardEdges{g} }
+
+// forwardEdges is the EdgeForward adapter of a Forward graph.
+type forwardEdges struct {
+	g Forward
+}
+
+func (g forwardEdges) OutEdges(v string) []Edge {
+	next := g.g.NextVertices(v)
+	edges := make([]Edge, len(next))
+	for i, w := range next {
+		edges[i] = Edge{From: v, To: w, Weight: 1}
+	}
+	return edges
+}
+
+// WeightForwardEdges adapts a WeightForward graph to the EdgeForward interface.
+// Edges have the weight given by g and no label.
+func WeightForwardEdges(g WeightForward) EdgeForward { return weightForwardEdges{g} }
+
+// weightForwardEdges is the EdgeForward adapter of a WeightForward graph.
+type weightForwardEdges struct {
+	g WeightForward
+}
+
+func (g weightForwardEdges) OutEdges(v string) []Edge {
+	next := g.g.NextVertices(v)
+	edges := make([]Edge, len(next))
+	for i, w := range next {
+		edges[i] = Edge{From: v, To: w, Weight: g.g.Weight(v, w)}
+	}
+	return edges
+}
+
+// forwardEdgeBuffer adapts a Forward graph to the EdgeForward interface
+// for the traversal functions.
+// Edges have no weight and no label.
+// The slice returned by OutEdges is reused by the next call,
+// so that visiting a Forward graph does not allocate a slice per vertex.
+type forwardEdgeBuffer struct {
+	g     Forward
+	edges []Edge
+}
+
+func (g *forwardEdgeBuffer) OutEdges(v string) []Edge {
+	g.edges = g.edges[:0]
+	for _, w := range g.g.NextVertices(v) {
+		g.edges = append(g.edges, Edge{From: v, To: w})
+	}
+	return g.edges
+}
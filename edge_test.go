@@ -0,0 +1,223 @@
+package graph
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// multigraph is a directed multigraph used by the tests.
+type multigraph struct {
+	vertices []string
+	out      map[string][]Edge
+}
+
+func (g multigraph) Vertices() []string       { return g.vertices }
+func (g multigraph) OutEdges(v string) []Edge { return g.out[v] }
+
+// edgeList is the VertexListEdgeForward adapter of a VertexListWeightForward graph.
+type edgeList struct {
+	EdgeForward
+	g VertexListWeightForward
+}
+
+func (g edgeList) Vertices() []string { return g.g.Vertices() }
+
+// events records the events of a traversal as strings.
+// Edges are written from-to/label:weight.
+// Vertex visitors have no labels and take the weights of edges in weight.
+type events struct {
+	list   []string
+	weight func(from, to string) float64
+}
+
+func (r *events) vertex(event, v string) { r.list = append(r.list, event+" "+v) }
+
+func (r *events) edge(event string, e Edge) {
+	r.list = append(r.list, fmt.Sprintf("%s %s-%s/%s:%g", event, e.From, e.To, e.Label, e.Weight))
+}
+
+func (r *events) pair(event, from, to string) {
+	r.edge(event, Edge{From: from, To: to, Weight: r.weight(from, to)})
+}
+
+func (r *events) InitializeVertex(v string) { r.vertex("initialize", v) }
+func (r *events) DiscoverVertex(v string)   { r.vertex("discover", v) }
+func (r *events) ExamineVertex(v string)    { r.vertex("examine", v) }
+func (r *events) FinishVertex(v string)     { r.vertex("finish", v) }
+
+// edgeEvents is a BfsEdgeVisitor, DfsEdgeVisitor and DijkstraEdgeVisitor recording events.
+type edgeEvents struct{ events }
+
+func (r *edgeEvents) ExamineEdge(e Edge)      { r.edge("examine", e) }
+func (r *edgeEvents) TreeEdge(e Edge)         { r.edge("tree", e) }
+func (r *edgeEvents) NonTreeEdge(e Edge)      { r.edge("nontree", e) }
+func (r *edgeEvents) GrayTarget(e Edge)       { r.edge("gray", e) }
+func (r *edgeEvents) BlackTarget(e Edge)      { r.edge("black", e) }
+func (r *edgeEvents) BackEdge(e Edge)         { r.edge("back", e) }
+func (r *edgeEvents) ForwardCrossEdge(e Edge) { r.edge("forwardcross", e) }
+func (r *edgeEvents) EdgeRelaxed(e Edge)      { r.edge("relaxed", e) }
+func (r *edgeEvents) EdgeNotRelaxed(e Edge)   { r.edge("notrelaxed", e) }
+
+// vertexEvents is a BfsVisitor, DfsVisitor and DijkstraVisitor recording events.
+type vertexEvents struct{ events }
+
+func (r *vertexEvents) ExamineEdge(v, w string)      { r.pair("examine", v, w) }
+func (r *vertexEvents) TreeEdge(v, w string)         { r.pair("tree", v, w) }
+func (r *vertexEvents) NonTreeEdge(v, w string)      { r.pair("nontree", v, w) }
+func (r *vertexEvents) GrayTarget(v, w string)       { r.pair("gray", v, w) }
+func (r *vertexEvents) BlackTarget(v, w string)      { r.pair("black", v, w) }
+func (r *vertexEvents) BackEdge(v, w string)         { r.pair("back", v, w) }
+func (r *vertexEvents) ForwardCrossEdge(v, w string) { r.pair("forwardcross", v, w) }
+func (r *vertexEvents) EdgeRelaxed(v, w string)      { r.pair("relaxed", v, w) }
+func (r *vertexEvents) EdgeNotRelaxed(v, w string)   { r.pair("notrelaxed", v, w) }
+
+// parallelEdges returns a multigraph with parallel edges a-b, a back edge b-a and a self-loop c-c.
+func parallelEdges() multigraph {
+	return multigraph{
+		vertices: []string{"a", "b", "c"},
+		out: map[string][]Edge{
+			"a": {{"a", "b", 1, "x"}, {"a", "b", 2, "y"}, {"a", "c", 3, "z"}},
+			"b": {{"b", "c", 4, "u"}, {"b", "a", 5, "v"}},
+			"c": {{"c", "c", 6, "w"}},
+		},
+	}
+}
+
+func checkEvents(t *testing.T, name string, got, want []string) {
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%s: wrong events", name)
+		for i := 0; i < len(got) || i < len(want); i++ {
+			var g, w string
+			if i < len(got) {
+				g = got[i]
+			}
+			if i < len(want) {
+				w = want[i]
+			}
+			t.Logf("got %-24q want %q", g, w)
+		}
+	}
+}
+
+func TestBreadthFirstVisitEdges(t *testing.T) {
+	vis := &edgeEvents{}
+	BreadthFirstVisitEdges(parallelEdges(), vis, "a")
+	checkEvents(t, "visit", vis.list, []string{
+		"discover a",
+		"examine a",
+		"examine a-b/x:1", "tree a-b/x:1", "discover b",
+		"examine a-b/y:2", "nontree a-b/y:2", "gray a-b/y:2",
+		"examine a-c/z:3", "tree a-c/z:3", "discover c",
+		"finish a",
+		"examine b",
+		"examine b-c/u:4", "nontree b-c/u:4", "gray b-c/u:4",
+		"examine b-a/v:5", "nontree b-a/v:5", "black b-a/v:5",
+		"finish b",
+		"examine c",
+		"examine c-c/w:6", "nontree c-c/w:6", "gray c-c/w:6",
+		"finish c",
+	})
+
+	vis = &edgeEvents{}
+	BreadthFirstSearchEdges(parallelEdges(), vis, "a", "b")
+	checkEvents(t, "search", vis.list, []string{
+		"discover a",
+		"examine a",
+		"examine a-b/x:1", "tree a-b/x:1", "discover b",
+	})
+}
+
+func TestDepthFirstVisitEdges(t *testing.T) {
+	vis := &edgeEvents{}
+	DepthFirstVisitEdges(parallelEdges(), vis)
+	checkEvents(t, "visit", vis.list, []string{
+		"initialize a", "initialize b", "initialize c",
+		"discover a",
+		"examine a-b/x:1", "tree a-b/x:1",
+		"discover b",
+		"examine b-c/u:4", "tree b-c/u:4",
+		"discover c",
+		"examine c-c/w:6", "back c-c/w:6",
+		"finish c",
+		"examine b-a/v:5", "back b-a/v:5",
+		"finish b",
+		"examine a-b/y:2", "forwardcross a-b/y:2",
+		"examine a-c/z:3", "forwardcross a-c/z:3",
+		"finish a",
+	})
+
+	vis = &edgeEvents{}
+	DepthFirstVisitFromEdges(parallelEdges(), vis, "b")
+	checkEvents(t, "visit from", vis.list, []string{
+		"discover b",
+		"examine b-c/u:4", "tree b-c/u:4",
+		"discover c",
+		"examine c-c/w:6", "back c-c/w:6",
+		"finish c",
+		"examine b-a/v:5", "tree b-a/v:5",
+		"discover a",
+		"examine a-b/x:1", "back a-b/x:1",
+		"examine a-b/y:2", "back a-b/y:2",
+		"examine a-c/z:3", "forwardcross a-c/z:3",
+		"finish a",
+		"finish b",
+	})
+}
+
+func TestForwardEdges(t *testing.T) {
+	g := adjacency{
+		vertices: []string{"a", "b", "c"},
+		next:     map[string][]string{"a": {"b", "c"}, "b": {"a"}},
+		weight:   map[[2]string]float64{{"a", "b"}: 7, {"a", "c"}: 0.5, {"b", "a"}: 2},
+	}
+
+	want := []Edge{{From: "a", To: "b", Weight: 1}, {From: "a", To: "c", Weight: 1}}
+	if got := ForwardEdges(g).OutEdges("a"); !reflect.DeepEqual(got, want) {
+		t.Errorf("ForwardEdges: got %v instead of %v", got, want)
+	}
+	want = []Edge{{From: "a", To: "b", Weight: 7}, {From: "a", To: "c", Weight: 0.5}}
+	if got := WeightForwardEdges(g).OutEdges("a"); !reflect.DeepEqual(got, want) {
+		t.Errorf("WeightForwardEdges: got %v instead of %v", got, want)
+	}
+	if got := WeightForwardEdges(g).OutEdges("c"); len(got) != 0 {
+		t.Errorf("WeightForwardEdges: got %v instead of no edge", got)
+	}
+}
+
+// TestEdgeTraversals checks that the traversals of random graphs
+// through WeightForwardEdges send the same events as the vertex traversals.
+func TestEdgeTraversals(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 100; trial++ {
+		g := randomAdjacency(rnd, 1+rnd.Intn(10), 0.3, 10)
+		edges := WeightForwardEdges(g)
+		source := g.vertices[rnd.Intn(len(g.vertices))]
+		target := g.vertices[rnd.Intn(len(g.vertices))]
+
+		vertexVis := &vertexEvents{events{weight: g.Weight}}
+		edgeVis := &edgeEvents{}
+		BreadthFirstVisit(g, vertexVis, source)
+		BreadthFirstVisitEdges(edges, edgeVis, source)
+		BreadthFirstSearch(g, vertexVis, source, target)
+		BreadthFirstSearchEdges(edges, edgeVis, source, target)
+		checkEvents(t, fmt.Sprintf("trial %d, breadth-first", trial), edgeVis.list, vertexVis.list)
+
+		vertexVis = &vertexEvents{events{weight: g.Weight}}
+		edgeVis = &edgeEvents{}
+		DepthFirstVisit(g, vertexVis)
+		DepthFirstVisitEdges(edgeList{edges, g}, edgeVis)
+		DepthFirstVisitFrom(g, vertexVis, source)
+		DepthFirstVisitFromEdges(edges, edgeVis, source)
+		checkEvents(t, fmt.Sprintf("trial %d, depth-first", trial), edgeVis.list, vertexVis.list)
+
+		vertexVis = &vertexEvents{events{weight: g.Weight}}
+		edgeVis = &edgeEvents{}
+		Dijkstra(g, vertexVis, source)
+		DijkstraEdges(edges, edgeVis, source)
+		DijkstraTo(g, vertexVis, source, target)
+		DijkstraEdgesTo(edges, edgeVis, source, target)
+		checkEvents(t, fmt.Sprintf("trial %d, Dijkstra", trial), edgeVis.list, vertexVis.list)
+	}
+}
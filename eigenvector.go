@@ -0,0 +1,207 @@
+package graph
+
+import "math"
+
+// PowerIterationOptions configures centralities computed by power iteration.
+// Zero values select the defaults.
+type PowerIterationOptions struct {
+	// Tolerance stops the iterations when the L1 distance between successive scores is below it.
+	// It defaults to 1e-9.
+	Tolerance float64
+
+	// MaxIterations is the maximum number of iterations.
+	// It defaults to 100.
+	MaxIterations int
+}
+
+// EigenvectorCentrality computes the eigenvector centrality of the vertices of a graph:
+// the score of a vertex is proportional to the sum of the scores of the vertices having an edge to it.
+// It is the principal eigenvector of the transposed adjacency matrix,
+// normalised to have a unit Euclidean norm.
+//
+// It uses power iteration on the shifted matrix I+A to avoid oscillations on bipartite graphs,
+// each iteration running in O(V+E) time.
+// Edges leading to vertices which are not listed by Vertices are ignored.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func EigenvectorCentrality(g VertexListForward, opts PowerIterationOptions) *Centrality {
+	vertices, out := indexAdjacency(g)
+	return powerIteration(vertices, opts, func(x, next []float64) {
+		copy(next, x)
+		for v, targets := range out {
+			for _, w := range targets {
+				next[w] += x[v]
+			}
+		}
+		normalizeEuclidean(next)
+	})
+}
+
+// Katz computes the Katz centrality of the vertices of a graph:
+// the score of a vertex is alpha times the sum of the scores of the vertices having an edge to it, plus beta.
+// It counts the walks ending at the vertex, a walk of length k being attenuated by alpha^k.
+// Scores are normalised to have a unit Euclidean norm.
+//
+// Alpha must be less than the inverse of the largest eigenvalue of the adjacency matrix
+// for the iterations to converge.
+//
+// Each iteration runs in O(V+E) time.
+// Edges leading to vertices which are not listed by Vertices are ignored.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func Katz(g VertexListForward, alpha, beta float64, opts PowerIterationOptions) *Centrality {
+	vertices, out := indexAdjacency(g)
+	result := powerIteration(vertices, opts, func(x, next []float64) {
+		for w := range next {
+			next[w] = beta
+		}
+		for v, targets := range out {
+			for _, w := range targets {
+				next[w] += alpha * x[v]
+			}
+		}
+	})
+
+	scores := make([]float64, len(vertices))
+	for i, v := range vertices {
+		scores[i] = result.Score[v]
+	}
+	normalizeEuclidean(scores)
+	for i, v := range vertices {
+		result.Score[v] = scores[i]
+	}
+	return result
+}
+
+// HITS computes the hub and authority scores of the vertices of a directed graph (Kleinberg).
+// A good authority is pointed to by good hubs and a good hub points to good authorities.
+// Both scores are normalised to sum to one.
+// Convergence diagnostics are those of the hub scores and are shared by both results.
+//
+// Each iteration runs in O(V+E) time.
+// Edges leading to vertices which are not listed by Vertices are ignored.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func HITS(g VertexListForward, opts PowerIterationOptions) (hubs, authorities *Centrality) {
+	vertices, out := indexAdjacency(g)
+	authority := make([]float64, len(vertices))
+	hubs = powerIteration(vertices, opts, func(hub, next []float64) {
+		// authorities from hubs
+		for w := range authority {
+			authority[w] = 0
+		}
+		for v, targets := range out {
+			for _, w := range targets {
+				authority[w] += hub[v]
+			}
+		}
+		normalizeSum(authority)
+
+		// hubs from authorities
+		for v, targets := range out {
+			next[v] = 0
+			for _, w := range targets {
+				next[v] += authority[w]
+			}
+		}
+		normalizeSum(next)
+	})
+
+	authorities = &Centrality{
+		Score:      make(map[string]float64, len(vertices)),
+		Iterations: hubs.Iterations,
+		Converged:  hubs.Converged,
+		Delta:      hubs.Delta,
+	}
+	for i, v := range vertices {
+		authorities.Score[v] = authority[i]
+	}
+	return hubs, authorities
+}
+
+// indexAdjacency returns the vertices of g and the indices of the next vertices of each vertex.
+// Edges leading to vertices which are not listed are ignored.
+func indexAdjacency(g VertexListForward) ([]string, [][]int) {
+	vertices := g.Vertices()
+	index := make(map[string]int, len(vertices))
+	for i, v := range vertices {
+		index[v] = i
+	}
+	out := make([][]int, len(vertices))
+	for i, v := range vertices {
+		for _, w := range g.NextVertices(v) {
+			if j, found := index[w]; found {
+				out[i] = append(out[i], j)
+			}
+		}
+	}
+	return vertices, out
+}
+
+// powerIteration repeatedly applies step to the scores, starting from the uniform distribution,
+// until the L1 distance between successive scores is below the tolerance.
+func powerIteration(vertices []string, opts PowerIterationOptions, step func(x, next []float64)) *Centrality {
+	if opts.Tolerance == 0 {
+		opts.Tolerance = 1e-9
+	}
+	if opts.MaxIterations == 0 {
+		opts.MaxIterations = 100
+	}
+
+	n := len(vertices)
+	result := &Centrality{Score: make(map[string]float64, n)}
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 1 / float64(n)
+	}
+	next := make([]float64, n)
+	for result.Iterations < opts.MaxIterations {
+		result.Iterations++
+		step(x, next)
+
+		result.Delta = 0
+		for i := range x {
+			result.Delta += math.Abs(next[i] - x[i])
+		}
+		x, next = next, x
+		if result.Delta < opts.Tolerance {
+			result.Converged = true
+			break
+		}
+	}
+
+	for i, v := range vertices {
+		result.Score[v] = x[i]
+	}
+	return result
+}
+
+// normalizeEuclidean scales x to have a unit Euclidean norm, unless it is zero.
+func normalizeEuclidean(x []float64) {
+	norm := 0.0
+	for _, s := range x {
+		norm += s * s
+	}
+	if norm > 0 {
+		norm = math.Sqrt(norm)
+		for i := range x {
+			x[i] /= norm
+		}
+	}
+}
+
+// normalizeSum scales x to sum to one, unless it is zero.
+func normalizeSum(x []float64) {
+	total := 0.0
+	for _, s := range x {
+		total += s
+	}
+	if total > 0 {
+		for i := range x {
+			x[i] /= total
+		}
+	}
+}
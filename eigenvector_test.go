@@ -0,0 +1,77 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// incoming returns the sum of the scores of the vertices having an edge to v.
+func incoming(g adjacency, score map[string]float64, v string) float64 {
+	sum := 0.0
+	for _, u := range g.vertices {
+		for _, w := range g.next[u] {
+			if w == v {
+				sum += score[u]
+			}
+		}
+	}
+	return sum
+}
+
+// TestPowerIterationCentralities checks on random connected undirected graphs
+// that eigenvector, Katz and HITS scores are fixed points of their definitions.
+func TestPowerIterationCentralities(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	opts := PowerIterationOptions{Tolerance: 1e-13, MaxIterations: 100000}
+	for trial := 0; trial < 100; trial++ {
+		g := randomConnectedUndirected(rnd, 2+rnd.Intn(7), 0.3)
+
+		// eigenvector: A^T x = lambda x
+		eigen := EigenvectorCentrality(g, opts)
+		if !eigen.Converged {
+			t.Fatalf("trial %d: eigenvector centrality did not converge", trial)
+		}
+		lambda := 0.0
+		for _, v := range g.vertices {
+			lambda += eigen.Score[v] * incoming(g, eigen.Score, v)
+		}
+		for _, v := range g.vertices {
+			if math.Abs(incoming(g, eigen.Score, v)-lambda*eigen.Score[v]) > 1e-6 {
+				t.Errorf("trial %d: %s is not a fixed point of eigenvector centrality", trial, v)
+			}
+		}
+
+		// Katz: x - alpha A^T x is constant
+		alpha := 0.05
+		katz := Katz(g, alpha, 1, opts)
+		beta := katz.Score[g.vertices[0]] - alpha*incoming(g, katz.Score, g.vertices[0])
+		for _, v := range g.vertices {
+			if math.Abs(katz.Score[v]-alpha*incoming(g, katz.Score, v)-beta) > 1e-9 {
+				t.Errorf("trial %d: %s is not a fixed point of Katz centrality", trial, v)
+			}
+		}
+
+		// HITS: authorities proportional to A^T hubs, hubs proportional to A authorities
+		hubs, authorities := HITS(g, opts)
+		totalA, totalH := 0.0, 0.0
+		for _, v := range g.vertices {
+			totalA += incoming(g, hubs.Score, v)
+			for _, w := range g.next[v] {
+				totalH += authorities.Score[w]
+			}
+		}
+		for _, v := range g.vertices {
+			if math.Abs(authorities.Score[v]-incoming(g, hubs.Score, v)/totalA) > 1e-6 {
+				t.Errorf("trial %d: authority of %s is not a fixed point", trial, v)
+			}
+			hub := 0.0
+			for _, w := range g.next[v] {
+				hub += authorities.Score[w]
+			}
+			if math.Abs(hubs.Score[v]-hub/totalH) > 1e-6 {
+				t.Errorf("trial %d: hub score of %s is not a fixed point", trial, v)
+			}
+		}
+	}
+}
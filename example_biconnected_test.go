@@ -0,0 +1,40 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+func ExampleBiconnectedComponents() {
+	// two triangles joined by the link C - D
+	// A - B - C - D - E - F
+	//  \-----/     \-----/
+	g := undirectedGraph{
+		"A": []string{"B", "C"},
+		"B": []string{"A", "C"},
+		"C": []string{"A", "B", "D"},
+		"D": []string{"C", "E", "F"},
+		"E": []string{"D", "F"},
+		"F": []string{"D", "E"},
+	}
+
+	fmt.Println("articulation points:", graph.ArticulationPoints(g))
+	for _, e := range graph.Bridges(g) {
+		fmt.Println("bridge:", e.From, e.To)
+	}
+	for _, component := range graph.BiconnectedComponents(g) {
+		fmt.Print("component:")
+		for _, e := range component {
+			fmt.Print(" ", e.From, "-", e.To)
+		}
+		fmt.Println()
+	}
+
+	// Output:
+	// articulation points: [C D]
+	// bridge: C D
+	// component: D-E E-F F-D
+	// component: C-D
+	// component: A-B B-C C-A
+}
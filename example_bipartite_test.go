@@ -0,0 +1,34 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+func ExampleIsBipartite() {
+	// a square is bipartite
+	square := undirectedGraph{
+		"A": []string{"B", "D"},
+		"B": []string{"A", "C"},
+		"C": []string{"B", "D"},
+		"D": []string{"A", "C"},
+	}
+	bipartite, coloring, _ := graph.IsBipartite(square)
+	fmt.Println(bipartite, coloring["A"], coloring["B"], coloring["C"], coloring["D"])
+
+	// a pentagon is not
+	pentagon := undirectedGraph{
+		"A": []string{"B", "E"},
+		"B": []string{"A", "C"},
+		"C": []string{"B", "D"},
+		"D": []string{"C", "E"},
+		"E": []string{"D", "A"},
+	}
+	bipartite, _, cycle := graph.IsBipartite(pentagon)
+	fmt.Println(bipartite, cycle)
+
+	// Output:
+	// true 0 1 0 1
+	// false [C B A E D]
+}
@@ -0,0 +1,33 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+func ExampleHopcroftKarp() {
+	// students and the projects they accept
+	g := digraph{
+		"ann":  []string{"compiler", "database"},
+		"bob":  []string{"compiler"},
+		"carl": []string{"compiler", "kernel"},
+		"dora": []string{"database"},
+	}
+	students := []string{"ann", "bob", "carl", "dora"}
+
+	matching, cover := graph.HopcroftKarp(g, students)
+
+	for _, s := range students {
+		if p, ok := matching[s]; ok {
+			fmt.Println(s, "->", p)
+		}
+	}
+	fmt.Println("cover:", cover)
+
+	// Output:
+	// ann -> compiler
+	// carl -> kernel
+	// dora -> database
+	// cover: [carl compiler database]
+}
@@ -0,0 +1,35 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+func ExampleBetweenness() {
+	// two triangles linked by a bridge between C and D
+	g := undirectedGraph{
+		"A": []string{"B", "C"},
+		"B": []string{"A", "C"},
+		"C": []string{"A", "B", "D"},
+		"D": []string{"C", "E", "F"},
+		"E": []string{"D", "F"},
+		"F": []string{"D", "E"},
+	}
+
+	opts := graph.PathCentralityOptions{Normalized: true}
+	betweenness := graph.Betweenness(g, opts)
+	closeness := graph.Closeness(g, opts)
+	harmonic := graph.Harmonic(g, opts)
+	for _, v := range g.Vertices() {
+		fmt.Printf("%s %.2f %.2f %.2f\n", v, betweenness[v], closeness[v], harmonic[v])
+	}
+
+	// Output:
+	// A 0.00 0.50 0.63
+	// B 0.00 0.50 0.63
+	// C 0.60 0.71 0.80
+	// D 0.60 0.71 0.80
+	// E 0.00 0.50 0.63
+	// F 0.00 0.50 0.63
+}
@@ -0,0 +1,30 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+func ExampleMaximalCliques() {
+	// two triangles sharing the edge B-C, and a pendant vertex E
+	g := undirectedGraph{
+		"A": []string{"B", "C"},
+		"B": []string{"A", "C", "D"},
+		"C": []string{"A", "B", "D"},
+		"D": []string{"B", "C", "E"},
+		"E": []string{"D"},
+	}
+
+	graph.MaximalCliques(g, func(clique []string) bool {
+		fmt.Println(clique)
+		return true
+	})
+	fmt.Println(graph.MaximumClique(g))
+
+	// Output:
+	// [D E]
+	// [A B C]
+	// [B C D]
+	// [A B C]
+}
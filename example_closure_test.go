@@ -0,0 +1,50 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+func ExampleTransitiveClosure() {
+	g := vertexListDAG{
+		"app":  []string{"lib"},
+		"lib":  []string{"util"},
+		"util": []string{"lib"},
+		"log":  nil,
+	}
+
+	closure := graph.TransitiveClosure(g)
+	fmt.Println(closure.Reachable("app", "util"))
+	fmt.Println(closure.Reachable("app", "app"))
+	fmt.Println(closure.NextVertices("lib"))
+
+	// Output:
+	// true
+	// false
+	// [lib util]
+}
+
+func ExampleTransitiveReduction() {
+	g := vertexListDAG{
+		"app":  []string{"lib", "log", "util"},
+		"lib":  []string{"log", "util"},
+		"util": []string{"log"},
+		"log":  nil,
+	}
+
+	reduction, err := graph.TransitiveReduction(g)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, v := range reduction.Vertices() {
+		fmt.Println(v, reduction.NextVertices(v))
+	}
+
+	// Output:
+	// app [lib]
+	// lib [util]
+	// log []
+	// util [log]
+}
@@ -0,0 +1,36 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+func ExampleCoreNumbers() {
+	// a clique of four friends, one of them knowing two other people
+	friends := undirectedGraph{
+		"ann":  []string{"ben", "cat", "dov", "eli"},
+		"ben":  []string{"ann", "cat", "dov"},
+		"cat":  []string{"ann", "ben", "dov"},
+		"dov":  []string{"ann", "ben", "cat"},
+		"eli":  []string{"ann", "finn"},
+		"finn": []string{"eli"},
+	}
+
+	core := graph.CoreNumbers(friends)
+	triangles := graph.Triangles(friends)
+	clustering := graph.LocalClustering(friends)
+	for _, v := range friends.Vertices() {
+		fmt.Printf("%s %d %d %.2f\n", v, core[v], triangles[v], clustering[v])
+	}
+	fmt.Printf("%.2f\n", graph.GlobalClustering(friends))
+
+	// Output:
+	// ann 3 3 0.50
+	// ben 3 3 1.00
+	// cat 3 3 1.00
+	// dov 3 3 1.00
+	// eli 1 0 0.00
+	// finn 1 0 0.00
+	// 0.75
+}
@@ -0,0 +1,48 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+func ExampleDSaturColoring() {
+	// exams sharing students cannot be scheduled at the same time
+	conflicts := undirectedGraph{
+		"algebra":   []string{"biology", "chemistry", "physics"},
+		"biology":   []string{"algebra", "chemistry"},
+		"chemistry": []string{"algebra", "biology", "drawing"},
+		"drawing":   []string{"chemistry", "physics"},
+		"physics":   []string{"algebra", "drawing"},
+	}
+
+	slot, slots := graph.DSaturColoring(conflicts)
+	for _, exam := range conflicts.Vertices() {
+		fmt.Println(exam, slot[exam])
+	}
+	fmt.Println(slots, graph.IsProperColoring(conflicts, slot))
+
+	// Output:
+	// algebra 0
+	// biology 2
+	// chemistry 1
+	// drawing 0
+	// physics 1
+	// 3 true
+}
+
+func ExampleGreedyColoring() {
+	conflicts := undirectedGraph{
+		"algebra":   []string{"biology", "chemistry", "physics"},
+		"biology":   []string{"algebra", "chemistry"},
+		"chemistry": []string{"algebra", "biology", "drawing"},
+		"drawing":   []string{"chemistry", "physics"},
+		"physics":   []string{"algebra", "drawing"},
+	}
+
+	_, slots := graph.GreedyColoring(conflicts, graph.SmallestLastOrder(conflicts))
+	fmt.Println(slots)
+
+	// Output:
+	// 3
+}
@@ -0,0 +1,58 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+// collaborations returns an undirected weighted graph:
+// the number of papers co-authored by two researchers.
+func collaborations() weightedDigraph {
+	papers := []struct {
+		u, v  string
+		count float64
+	}{
+		{"ada", "bob", 3}, {"ada", "cid", 2}, {"bob", "cid", 4},
+		{"dan", "eve", 2}, {"dan", "fay", 3}, {"eve", "fay", 2},
+		{"cid", "dan", 1},
+	}
+
+	g := weightedDigraph{next: make(map[string][]string), weight: make(map[string]float64)}
+	for _, p := range papers {
+		g.next[p.u] = append(g.next[p.u], p.v)
+		g.next[p.v] = append(g.next[p.v], p.u)
+		g.weight[p.u+"-"+p.v] = p.count
+		g.weight[p.v+"-"+p.u] = p.count
+	}
+	return g
+}
+
+func ExampleLouvain() {
+	g := collaborations()
+
+	community, modularity := graph.Louvain(g, graph.CommunityOptions{Seed: 1})
+	for _, v := range g.Vertices() {
+		fmt.Println(v, community[v])
+	}
+	fmt.Printf("%.3f\n", modularity)
+
+	// Output:
+	// ada 0
+	// bob 0
+	// cid 0
+	// dan 1
+	// eve 1
+	// fay 1
+	// 0.434
+}
+
+func ExampleLabelPropagation() {
+	g := collaborations()
+
+	community, _ := graph.LabelPropagation(g, graph.CommunityOptions{Seed: 1})
+	fmt.Println(community["ada"] == community["bob"], community["bob"] == community["eve"])
+
+	// Output:
+	// true false
+}
@@ -0,0 +1,58 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+func ExampleConnectedComponents() {
+	g := undirectedGraph{
+		"A": []string{"B"},
+		"B": []string{"A", "C"},
+		"C": []string{"B"},
+		"D": []string{"E"},
+		"E": []string{"D"},
+		"F": nil,
+	}
+
+	component, sizes := graph.ConnectedComponents(g)
+	for _, v := range g.Vertices() {
+		fmt.Println(v, component[v])
+	}
+	fmt.Println("sizes:", sizes)
+
+	// Output:
+	// A 0
+	// B 0
+	// C 0
+	// D 1
+	// E 1
+	// F 2
+	// sizes: [3 2 1]
+}
+
+func ExampleWeaklyConnectedComponents() {
+	// B and C cannot reach each other but are weakly connected through A
+	g := vertexListDAG{
+		"A": []string{"B", "C"},
+		"B": nil,
+		"C": nil,
+		"D": []string{"C"},
+		"E": nil,
+	}
+
+	component, sizes := graph.WeaklyConnectedComponents(g)
+	for _, v := range g.Vertices() {
+		fmt.Println(v, component[v])
+	}
+	fmt.Println("sizes:", sizes)
+
+	// Output:
+	// A 0
+	// B 0
+	// C 0
+	// D 0
+	// E 1
+	// sizes: [4 1]
+}
@@ -0,0 +1,60 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+func ExampleFindCycle() {
+	// dependencies: app -> lib -> util -> log -> lib
+	g := vertexListDAG{
+		"app":  []string{"lib"},
+		"lib":  []string{"util"},
+		"util": []string{"log"},
+		"log":  []string{"lib"},
+	}
+
+	fmt.Println(graph.FindCycle(g))
+
+	// Output:
+	// [lib util log]
+}
+
+func ExampleElementaryCircuits() {
+	g := vertexListDAG{
+		"A": []string{"B"},
+		"B": []string{"A", "C"},
+		"C": []string{"A", "C"},
+	}
+
+	graph.ElementaryCircuits(g, 0, func(circuit []string) bool {
+		fmt.Println(circuit)
+		return true
+	})
+
+	// Output:
+	// [A B]
+	// [A B C]
+	// [C]
+}
+
+func ExampleFindUndirectedCycle() {
+	tree := undirectedGraph{
+		"A": []string{"B", "C"},
+		"B": []string{"A"},
+		"C": []string{"A"},
+	}
+	fmt.Println(graph.FindUndirectedCycle(tree))
+
+	triangle := undirectedGraph{
+		"A": []string{"B", "C"},
+		"B": []string{"A", "C"},
+		"C": []string{"A", "B"},
+	}
+	fmt.Println(graph.FindUndirectedCycle(triangle))
+
+	// Output:
+	// []
+	// [A B C]
+}
@@ -0,0 +1,78 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+func ExampleCriticalPath() {
+	// build schedule: edges are tasks and weights are durations
+	//   start -2-> fetch -3-> compile -1-> package
+	//   start -1-> configure -1--/^     \-4-> test -> package
+	g := weightedDigraph{
+		next: map[string][]string{
+			"start":     []string{"fetch", "configure"},
+			"fetch":     []string{"compile"},
+			"configure": []string{"compile"},
+			"compile":   []string{"package", "test"},
+			"test":      []string{"package"},
+			"package":   nil,
+		},
+		weight: map[string]float64{
+			"start-fetch":       2,
+			"start-configure":   1,
+			"fetch-compile":     3,
+			"configure-compile": 1,
+			"compile-package":   1,
+			"compile-test":      4,
+			"test-package":      0,
+		},
+	}
+
+	s, err := graph.CriticalPath(g)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println("length:", s.Length)
+	fmt.Println("critical path:", s.Path)
+	fmt.Println("slack of configure:", s.Slack["configure"])
+
+	// Output:
+	// length: 9
+	// critical path: [start fetch compile test package]
+	// slack of configure: 3
+}
+
+func ExampleDAGLongestPaths() {
+	g := weightedDigraph{
+		next: map[string][]string{
+			"A": []string{"B", "C"},
+			"B": []string{"D"},
+			"C": []string{"D"},
+			"D": nil,
+			"E": []string{"A"},
+		},
+		weight: map[string]float64{
+			"A-B": 1,
+			"A-C": 2,
+			"B-D": 4,
+			"C-D": 1,
+			"E-A": 1,
+		},
+	}
+
+	longest, _ := graph.DAGLongestPaths(g, "A")
+	shortest, _ := graph.DAGShortestPaths(g, "A")
+
+	fmt.Println("longest:", longest.Distance("D"), longest.Path("D"))
+	fmt.Println("shortest:", shortest.Distance("D"), shortest.Path("D"))
+	fmt.Println("unreachable:", longest.Distance("E"), shortest.Distance("E"))
+
+	// Output:
+	// longest: 5 [A B D]
+	// shortest: 3 [A C D]
+	// unreachable: -Inf +Inf
+}
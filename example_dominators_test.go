@@ -0,0 +1,36 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+func ExampleDominators() {
+	// control flow graph of a loop containing an if-then-else
+	cfg := vertexListDAG{
+		"entry": []string{"loop"},
+		"loop":  []string{"then", "else", "exit"},
+		"then":  []string{"join"},
+		"else":  []string{"join"},
+		"join":  []string{"loop"},
+		"exit":  nil,
+	}
+
+	tree := graph.Dominators(cfg, "entry")
+	for _, v := range cfg.Vertices() {
+		fmt.Println(v, tree.Idom[v], tree.Frontier[v])
+	}
+
+	post := graph.PostDominators(cfg, "exit")
+	fmt.Println(post.Idom["then"], post.Dominates("loop", "entry"))
+
+	// Output:
+	// else loop [join]
+	// entry  []
+	// exit loop []
+	// join loop [loop]
+	// loop entry [loop]
+	// then loop [join]
+	// join true
+}
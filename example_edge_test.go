@@ -0,0 +1,60 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+	"github.com/batiazinga/graph/visitor"
+)
+
+// flights is a multigraph of flights between airports implementing the EdgeForward interface.
+// Edges are labelled with flight numbers and weighted with prices.
+type flights map[string][]graph.Edge
+
+func (g flights) OutEdges(v string) []graph.Edge { return g[v] }
+
+// dijkstraVisitorEdgePath records the edge leading to each vertex on shortest paths.
+type dijkstraVisitorEdgePath struct {
+	visitor.DijkstraEdgeNoOp // dijkstraVisitorEdgePath implement DijkstraEdgeVisitor
+
+	// predecessor edge map
+	pred map[string]graph.Edge
+}
+
+func (vis *dijkstraVisitorEdgePath) EdgeRelaxed(e graph.Edge) {
+	vis.pred[e.To] = e
+}
+
+func ExampleDijkstraEdgesTo() {
+	// several flights link the same airports
+	g := flights{
+		"CDG": []graph.Edge{
+			{From: "CDG", To: "JFK", Weight: 650, Label: "AF006"},
+			{From: "CDG", To: "JFK", Weight: 420, Label: "DL401"},
+			{From: "CDG", To: "LHR", Weight: 90, Label: "BA305"},
+		},
+		"LHR": []graph.Edge{
+			{From: "LHR", To: "JFK", Weight: 400, Label: "BA117"},
+			{From: "LHR", To: "JFK", Weight: 310, Label: "VS003"},
+		},
+	}
+
+	// create a path visitor
+	vis := &dijkstraVisitorEdgePath{
+		DijkstraEdgeNoOp: visitor.DijkstraEdgeNoOp{},
+		pred:             make(map[string]graph.Edge),
+	}
+
+	// run the dijkstra visit
+	graph.DijkstraEdgesTo(g, vis, "CDG", "JFK")
+
+	// read results backward
+	for v := "JFK"; v != "CDG"; v = vis.pred[v].From {
+		e := vis.pred[v]
+		fmt.Println(e.Label, e.From, "->", e.To, e.Weight)
+	}
+
+	// Output:
+	// VS003 LHR -> JFK 310
+	// BA305 CDG -> LHR 90
+}
@@ -0,0 +1,57 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+func ExampleEigenvectorCentrality() {
+	// a star with center A and a triangle A, B, C
+	g := undirectedGraph{
+		"A": []string{"B", "C", "D", "E"},
+		"B": []string{"A", "C"},
+		"C": []string{"A", "B"},
+		"D": []string{"A"},
+		"E": []string{"A"},
+	}
+
+	result := graph.EigenvectorCentrality(g, graph.PowerIterationOptions{})
+	for _, v := range g.Vertices() {
+		fmt.Printf("%s %.3f\n", v, result.Score[v])
+	}
+	fmt.Println(result.Converged)
+
+	// Output:
+	// A 0.636
+	// B 0.473
+	// C 0.473
+	// D 0.271
+	// E 0.271
+	// true
+}
+
+func ExampleHITS() {
+	// portals linking to pages
+	g := vertexListDAG{
+		"portal1": []string{"news", "weather"},
+		"portal2": []string{"news", "weather", "sport"},
+		"blog":    []string{"news"},
+		"news":    nil,
+		"weather": nil,
+		"sport":   nil,
+	}
+
+	hubs, authorities := graph.HITS(g, graph.PowerIterationOptions{})
+	for _, v := range g.Vertices() {
+		fmt.Printf("%s %.3f %.3f\n", v, hubs.Score[v], authorities.Score[v])
+	}
+
+	// Output:
+	// blog 0.198 0.000
+	// news 0.000 0.445
+	// portal1 0.357 0.000
+	// portal2 0.445 0.000
+	// sport 0.000 0.198
+	// weather 0.000 0.357
+}
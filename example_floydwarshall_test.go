@@ -0,0 +1,62 @@
+package graph_test
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/batiazinga/graph"
+)
+
+// weightedDigraph is a directed graph implementing the VertexListWeightForward interface.
+// All vertices must be keys of the next map.
+type weightedDigraph struct {
+	next   map[string][]string
+	weight map[string]float64 // weight of edge from-to
+}
+
+func (g weightedDigraph) NextVertices(v string) []string { return g.next[v] }
+func (g weightedDigraph) Weight(v, w string) float64     { return g.weight[v+"-"+w] }
+
+func (g weightedDigraph) Vertices() []string {
+	vertices := make([]string, 0, len(g.next))
+	for v := range g.next {
+		vertices = append(vertices, v)
+	}
+	// sort to make order deterministic
+	sort.Strings(vertices)
+	return vertices
+}
+
+func ExampleFloydWarshall() {
+	// create the following digraph
+	// A -3-> B -1-> C
+	//  \------5----/^
+	// D -(-2)-> A
+	g := weightedDigraph{
+		next: map[string][]string{
+			"A": []string{"B", "C"},
+			"B": []string{"C"},
+			"C": nil,
+			"D": []string{"A"},
+		},
+		weight: map[string]float64{
+			"A-B": 3,
+			"A-C": 5,
+			"B-C": 1,
+			"D-A": -2,
+		},
+	}
+
+	// compute all shortest paths
+	apsp := graph.FloydWarshall(g)
+
+	// read results
+	fmt.Println("negative cycle:", apsp.NegativeCycle())
+	fmt.Println("D to C:", apsp.Distance("D", "C"), apsp.Path("D", "C"))
+	fmt.Println("C to A:", apsp.Distance("C", "A"), apsp.Path("C", "A"))
+
+	// Output:
+	// negative cycle: false
+	// D to C: 2 [D A B C]
+	// C to A: +Inf []
+}
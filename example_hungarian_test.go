@@ -0,0 +1,60 @@
+package graph_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/batiazinga/graph"
+)
+
+func ExampleHungarianMatrix() {
+	// cost of each worker (rows) for each task (columns)
+	// the last worker cannot do the first task
+	inf := math.Inf(1)
+	cost := [][]float64{
+		{4, 1, 3},
+		{2, 0, 5},
+		{inf, 2, 2},
+	}
+
+	assignment, total := graph.HungarianMatrix(cost, false)
+	fmt.Println("minimum:", assignment, total)
+
+	assignment, total = graph.HungarianMatrix(cost, true)
+	fmt.Println("maximum:", assignment, total)
+
+	// Output:
+	// minimum: [1 0 2] 5
+	// maximum: [0 2 1] 11
+}
+
+func ExampleHungarian() {
+	// preferences of students for the projects they accept
+	g := weightedDigraph{
+		next: map[string][]string{
+			"ann":  []string{"compiler", "database"},
+			"bob":  []string{"compiler"},
+			"carl": []string{"compiler", "kernel"},
+		},
+		weight: map[string]float64{
+			"ann-compiler":  3,
+			"ann-database":  1,
+			"bob-compiler":  2,
+			"carl-compiler": 5,
+			"carl-kernel":   4,
+		},
+	}
+	students := []string{"ann", "bob", "carl"}
+
+	assignment, total := graph.Hungarian(g, students, true)
+	for _, s := range students {
+		fmt.Println(s, "->", assignment[s])
+	}
+	fmt.Println("total preference:", total)
+
+	// Output:
+	// ann -> database
+	// bob -> compiler
+	// carl -> kernel
+	// total preference: 7
+}
@@ -0,0 +1,44 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+func ExampleAncestorsFromTree() {
+	// organisation chart
+	tree := digraph{
+		"ceo":     []string{"cto", "cfo"},
+		"cto":     []string{"backend", "frontend"},
+		"cfo":     []string{"payroll"},
+		"backend": []string{"database"},
+	}
+
+	a := graph.AncestorsFromTree(tree, "ceo")
+	fmt.Println(a.LCA("database", "frontend"))
+	fmt.Println(a.Distance("database", "payroll"))
+
+	// Output:
+	// cto true
+	// 5 true
+}
+
+func ExampleAncestorsFromParents() {
+	parent := map[string]string{
+		"B": "A",
+		"C": "A",
+		"D": "B",
+		"F": "E",
+	}
+
+	a := graph.AncestorsFromParents(parent)
+	fmt.Println(a.LCA("D", "C"))
+	fmt.Println(a.LCA("D", "F"))
+	fmt.Println(a.Ancestor("D", 2))
+
+	// Output:
+	// A true
+	//  false
+	// A true
+}
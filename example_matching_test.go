@@ -0,0 +1,47 @@
+package graph_test
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/batiazinga/graph"
+)
+
+// undirectedGraph is an undirected graph implementing the VertexListForward interface.
+// Each edge is listed in both directions and all vertices must be keys of the map.
+type undirectedGraph map[string][]string
+
+func (g undirectedGraph) NextVertices(v string) []string { return g[v] }
+
+func (g undirectedGraph) Vertices() []string {
+	vertices := make([]string, 0, len(g))
+	for v := range g {
+		vertices = append(vertices, v)
+	}
+	// sort to make order deterministic
+	sort.Strings(vertices)
+	return vertices
+}
+
+func ExampleMaximumMatching() {
+	// reviewers who can review each other's work
+	// ann - bob - carl - dora, and a triangle ann - bob - eve
+	g := undirectedGraph{
+		"ann":  []string{"bob", "eve"},
+		"bob":  []string{"ann", "carl", "eve"},
+		"carl": []string{"bob", "dora"},
+		"dora": []string{"carl"},
+		"eve":  []string{"ann", "bob"},
+	}
+
+	matching := graph.MaximumMatching(g)
+	for _, v := range g.Vertices() {
+		if w, ok := matching[v]; ok && v < w {
+			fmt.Println(v, "-", w)
+		}
+	}
+
+	// Output:
+	// ann - eve
+	// carl - dora
+}
@@ -0,0 +1,48 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+// pipes is a directed graph implementing the CapacityForward interface.
+type pipes struct {
+	next     map[string][]string
+	capacity map[string]float64 // capacity of edge from-to
+}
+
+func (g pipes) NextVertices(v string) []string { return g.next[v] }
+func (g pipes) Capacity(v, w string) float64   { return g.capacity[v+"-"+w] }
+
+func ExampleDinic() {
+	// create the following network
+	// s -3-> a -2-> t
+	//  \-2-> b -3--/^
+	// and a -1-> b
+	g := pipes{
+		next: map[string][]string{
+			"s": []string{"a", "b"},
+			"a": []string{"b", "t"},
+			"b": []string{"t"},
+		},
+		capacity: map[string]float64{
+			"s-a": 3,
+			"s-b": 2,
+			"a-b": 1,
+			"a-t": 2,
+			"b-t": 3,
+		},
+	}
+
+	f := graph.Dinic(g, "s", "t")
+
+	fmt.Println("max flow:", f.Value())
+	fmt.Println("flow a-b:", f.EdgeFlow("a", "b"))
+	fmt.Println("source side:", f.Reachable("s"), f.Reachable("a"), f.Reachable("b"))
+
+	// Output:
+	// max flow: 5
+	// flow a-b: 1
+	// source side: true false false
+}
@@ -0,0 +1,62 @@
+package graph_test
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/batiazinga/graph"
+)
+
+// costPipes is a directed graph implementing the CostCapacityForward interface.
+type costPipes struct {
+	pipes
+	cost map[string]float64 // cost of edge from-to
+}
+
+func (g costPipes) Cost(v, w string) float64 { return g.cost[v+"-"+w] }
+
+func ExampleMinCostFlow() {
+	// assign three jobs to two workers:
+	// alice can do two jobs and bob only one
+	g := costPipes{
+		pipes: pipes{
+			next: map[string][]string{
+				"source": []string{"alice", "bob"},
+				"alice":  []string{"job1", "job2", "job3"},
+				"bob":    []string{"job1", "job2", "job3"},
+				"job1":   []string{"sink"},
+				"job2":   []string{"sink"},
+				"job3":   []string{"sink"},
+			},
+			capacity: map[string]float64{
+				"source-alice": 2, "source-bob": 1,
+				"alice-job1": 1, "alice-job2": 1, "alice-job3": 1,
+				"bob-job1": 1, "bob-job2": 1, "bob-job3": 1,
+				"job1-sink": 1, "job2-sink": 1, "job3-sink": 1,
+			},
+		},
+		cost: map[string]float64{
+			"alice-job1": 4, "alice-job2": 2, "alice-job3": 5,
+			"bob-job1": 2, "bob-job2": 1, "bob-job3": 7,
+		},
+	}
+
+	f, cost := graph.MinCostFlow(g, "source", "sink", math.Inf(1))
+
+	fmt.Println("assigned jobs:", f.Value())
+	fmt.Println("total cost:", cost)
+	for _, job := range []string{"job1", "job2", "job3"} {
+		for _, worker := range []string{"alice", "bob"} {
+			if f.EdgeFlow(worker, job) > 0 {
+				fmt.Println(job, "->", worker)
+			}
+		}
+	}
+
+	// Output:
+	// assigned jobs: 3
+	// total cost: 9
+	// job1 -> bob
+	// job2 -> alice
+	// job3 -> alice
+}
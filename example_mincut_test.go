@@ -0,0 +1,63 @@
+package graph_test
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/batiazinga/graph"
+)
+
+// links is a directed network implementing the VertexListCapacityForward interface.
+// All vertices must be keys of the next map.
+type links struct {
+	pipes
+}
+
+func (g links) Vertices() []string {
+	vertices := make([]string, 0, len(g.next))
+	for v := range g.next {
+		vertices = append(vertices, v)
+	}
+	// sort to make order deterministic
+	sort.Strings(vertices)
+	return vertices
+}
+
+func ExampleMinCut() {
+	// two sites linked by a few links
+	g := links{pipes{
+		next: map[string][]string{
+			"paris":  []string{"lyon", "lille"},
+			"lyon":   []string{"milan"},
+			"lille":  []string{"lyon", "milan"},
+			"milan":  []string{"rome"},
+			"rome":   nil,
+			"madrid": []string{"rome"},
+		},
+		capacity: map[string]float64{
+			"paris-lyon":  10,
+			"paris-lille": 10,
+			"lyon-milan":  4,
+			"lille-lyon":  5,
+			"lille-milan": 3,
+			"milan-rome":  20,
+			"madrid-rome": 5,
+		},
+	}}
+
+	cut := graph.MinCut(g, "paris", "rome")
+
+	fmt.Println("capacity:", cut.Capacity)
+	fmt.Println("source side:", cut.Source)
+	fmt.Println("sink side:", cut.Sink)
+	for _, e := range cut.Edges {
+		fmt.Println("bottleneck:", e.From, "->", e.To, e.Weight)
+	}
+
+	// Output:
+	// capacity: 7
+	// source side: [lille lyon paris]
+	// sink side: [madrid milan rome]
+	// bottleneck: lille -> milan 3
+	// bottleneck: lyon -> milan 4
+}
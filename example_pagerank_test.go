@@ -0,0 +1,38 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+func ExamplePageRank() {
+	links := vertexListDAG{
+		"home":    []string{"about", "blog"},
+		"about":   []string{"home"},
+		"blog":    []string{"home", "post"},
+		"post":    []string{"blog"},
+		"contact": nil,
+	}
+
+	result := graph.PageRank(links, graph.PageRankOptions{})
+	for _, v := range links.Vertices() {
+		fmt.Printf("%s %.3f\n", v, result.Score[v])
+	}
+	fmt.Println(result.Converged)
+
+	// personalised on the blog
+	result = graph.PageRank(links, graph.PageRankOptions{
+		Personalization: map[string]float64{"blog": 1},
+	})
+	fmt.Printf("%.3f %.3f\n", result.Score["blog"], result.Score["contact"])
+
+	// Output:
+	// about 0.169
+	// blog 0.313
+	// contact 0.036
+	// home 0.313
+	// post 0.169
+	// true
+	// 0.421 0.000
+}
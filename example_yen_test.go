@@ -0,0 +1,41 @@
+package graph_test
+
+import (
+	"fmt"
+
+	"github.com/batiazinga/graph"
+)
+
+func ExampleKShortestPaths() {
+	// directed graph from the Wikipedia page of Yen's algorithm
+	g := weightedDigraph{
+		next: map[string][]string{
+			"C": []string{"D", "E"},
+			"D": []string{"F"},
+			"E": []string{"D", "F", "G"},
+			"F": []string{"G", "H"},
+			"G": []string{"H"},
+			"H": nil,
+		},
+		weight: map[string]float64{
+			"C-D": 3,
+			"C-E": 2,
+			"D-F": 4,
+			"E-D": 1,
+			"E-F": 2,
+			"E-G": 3,
+			"F-G": 2,
+			"F-H": 1,
+			"G-H": 2,
+		},
+	}
+
+	for _, p := range graph.KShortestPaths(g, "C", "H", 3) {
+		fmt.Println(p.Weight, p.Vertices)
+	}
+
+	// Output:
+	// 5 [C E F H]
+	// 7 [C E G H]
+	// 8 [C D F H]
+}
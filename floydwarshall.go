@@ -0,0 +1,134 @@
+package graph
+
+import "math"
+
+// AllPairsShortestPaths holds the shortest distances and paths between all pairs of vertices of a graph.
+type AllPairsShortestPaths struct {
+	vertices []string       // list of vertices
+	index    map[string]int // position of the vertices in the matrices
+	dist     [][]float64    // distance matrix
+	next     [][]int        // next hop matrix, -1 if there is no path
+
+	negativeCycle bool // whether the graph has a negative cycle
+}
+
+// FloydWarshall computes the shortest distances and paths between all pairs of vertices of g.
+// It runs in O(V^3) time and uses O(V^2) memory, so it is best suited for small dense graphs.
+//
+// Negative weights are allowed.
+// Negative cycles are detected: see NegativeCycle and Distance.
+// Edges leading to vertices which are not listed by Vertices are ignored.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func FloydWarshall(g VertexListWeightForward) *AllPairsShortestPaths {
+	vertices := g.Vertices()
+	n := len(vertices)
+	index := make(map[string]int, n)
+	for i, v := range vertices {
+		index[v] = i
+	}
+
+	// init matrices with the edges of g
+	inf := math.Inf(1)
+	dist := make([][]float64, n)
+	next := make([][]int, n)
+	for i := range vertices {
+		dist[i] = make([]float64, n)
+		next[i] = make([]int, n)
+		for j := range dist[i] {
+			dist[i][j] = inf
+			next[i][j] = -1
+		}
+		dist[i][i] = 0
+		next[i][i] = i
+	}
+	for i, v := range vertices {
+		for _, w := range g.NextVertices(v) {
+			j, found := index[w]
+			if !found {
+				continue
+			}
+			if d := g.Weight(v, w); d < dist[i][j] {
+				dist[i][j] = d
+				next[i][j] = j
+			}
+		}
+	}
+
+	// try all intermediate vertices k
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if math.IsInf(dist[i][k], 1) {
+				continue // no path through k
+			}
+			for j := 0; j < n; j++ {
+				if d := dist[i][k] + dist[k][j]; d < dist[i][j] {
+					dist[i][j] = d
+					next[i][j] = next[i][k]
+				}
+			}
+		}
+	}
+
+	// vertices on a negative cycle are at a negative distance from themselves:
+	// paths going through them can be made arbitrarily short
+	apsp := &AllPairsShortestPaths{
+		vertices: vertices,
+		index:    index,
+		dist:     dist,
+		next:     next,
+	}
+	for k := 0; k < n; k++ {
+		if dist[k][k] >= 0 {
+			continue
+		}
+		apsp.negativeCycle = true
+		for i := 0; i < n; i++ {
+			if math.IsInf(dist[i][k], 1) {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if !math.IsInf(dist[k][j], 1) {
+					dist[i][j] = math.Inf(-1)
+				}
+			}
+		}
+	}
+
+	return apsp
+}
+
+// NegativeCycle returns true if the graph has a cycle of negative weight.
+func (p *AllPairsShortestPaths) NegativeCycle() bool { return p.negativeCycle }
+
+// Distance returns the length of the shortest path from u to v.
+//
+// It returns +Inf if v is not reachable from u or if one of them is not a vertex of the graph.
+// It returns -Inf if a path from u to v can go through a negative cycle.
+func (p *AllPairsShortestPaths) Distance(u, v string) float64 {
+	i, okU := p.index[u]
+	j, okV := p.index[v]
+	if !okU || !okV {
+		return math.Inf(1)
+	}
+	return p.dist[i][j]
+}
+
+// Path returns the vertices of the shortest path from u to v, both included.
+//
+// It returns nil if there is no shortest path,
+// i.e. if Distance(u, v) is infinite.
+func (p *AllPairsShortestPaths) Path(u, v string) []string {
+	if math.IsInf(p.Distance(u, v), 0) {
+		return nil
+	}
+
+	i, j := p.index[u], p.index[v]
+	path := []string{u}
+	for i != j {
+		i = p.next[i][j]
+		path = append(path, p.vertices[i])
+	}
+	return path
+}
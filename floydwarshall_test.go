@@ -0,0 +1,71 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+// TestFloydWarshallNegativeCycle checks that distances through a negative cycle are -Inf
+// and that other distances are not affected.
+func TestFloydWarshallNegativeCycle(t *testing.T) {
+	// A -> B <-> C -> D, E -> A
+	// B-C-B is a negative cycle
+	g := adjacency{
+		vertices: []string{"A", "B", "C", "D", "E"},
+		next: map[string][]string{
+			"A": []string{"B"},
+			"B": []string{"C"},
+			"C": []string{"B", "D"},
+			"E": []string{"A"},
+		},
+		weight: map[[2]string]float64{
+			{"A", "B"}: 1,
+			{"B", "C"}: 1,
+			{"C", "B"}: -3,
+			{"C", "D"}: 1,
+			{"E", "A"}: 2,
+		},
+	}
+
+	apsp := FloydWarshall(g)
+	if !apsp.NegativeCycle() {
+		t.Errorf("negative cycle not detected")
+	}
+
+	testcases := []struct {
+		from, to string
+		distance float64
+	}{
+		{"A", "D", math.Inf(-1)},
+		{"E", "B", math.Inf(-1)},
+		{"B", "B", math.Inf(-1)},
+		{"E", "A", 2},
+		{"D", "A", math.Inf(1)},
+		{"A", "unknown", math.Inf(1)},
+	}
+	for _, tc := range testcases {
+		if d := apsp.Distance(tc.from, tc.to); d != tc.distance {
+			t.Errorf("wrong distance from %s to %s: %v instead of %v", tc.from, tc.to, d, tc.distance)
+		}
+		if math.IsInf(tc.distance, 0) && apsp.Path(tc.from, tc.to) != nil {
+			t.Errorf("unexpected path from %s to %s", tc.from, tc.to)
+		}
+	}
+}
+
+// TestFloydWarshallUnlistedVertex checks that edges leading to vertices which are not listed are ignored.
+func TestFloydWarshallUnlistedVertex(t *testing.T) {
+	g := adjacency{
+		vertices: []string{"a", "b"},
+		next:     map[string][]string{"b": []string{"x"}},
+		weight:   map[[2]string]float64{{"b", "x"}: 1},
+	}
+
+	apsp := FloydWarshall(g)
+	if d := apsp.Distance("b", "a"); !math.IsInf(d, 1) {
+		t.Errorf("wrong distance from b to a: %v instead of +Inf", d)
+	}
+	if d := apsp.Distance("b", "x"); !math.IsInf(d, 1) {
+		t.Errorf("wrong distance from b to x: %v instead of +Inf", d)
+	}
+}
@@ -0,0 +1,201 @@
+package graph
+
+import "math"
+
+// HungarianMatrix solves the assignment problem on a dense cost matrix:
+// cost[i][j] is the cost of assigning row i to column j.
+// The matrix can be rectangular but all rows must have the same length.
+// Pairs with an infinite cost (of either sign) are forbidden.
+//
+// As many rows as possible are assigned to distinct columns.
+// Among such assignments, the one with minimum total cost is returned,
+// or the one with maximum total cost if maximize is true.
+// It returns the column assigned to each row, -1 for unassigned rows,
+// and the total cost.
+//
+// It is the Hungarian (Kuhn-Munkres) algorithm and runs in O(n^2 m) time
+// where n and m are the smallest and largest dimensions of the matrix.
+func HungarianMatrix(cost [][]float64, maximize bool) (assignment []int, total float64) {
+	rows := len(cost)
+	assignment = make([]int, rows)
+	for i := range assignment {
+		assignment[i] = -1
+	}
+	if rows == 0 || len(cost[0]) == 0 {
+		return assignment, 0
+	}
+	cols := len(cost[0])
+
+	// forbidden pairs get a cost higher than any difference between assignments
+	var big float64 = 1
+	for _, row := range cost {
+		for _, c := range row {
+			if !math.IsInf(c, 0) {
+				big += 2 * math.Abs(c)
+			}
+		}
+	}
+
+	// the solver needs no more rows than columns: transpose if needed
+	transposed := rows > cols
+	n, m := rows, cols
+	if transposed {
+		n, m = cols, rows
+	}
+	a := make([][]float64, n)
+	for i := range a {
+		a[i] = make([]float64, m)
+		for j := range a[i] {
+			var c float64
+			if transposed {
+				c = cost[j][i]
+			} else {
+				c = cost[i][j]
+			}
+			switch {
+			case math.IsInf(c, 0):
+				a[i][j] = big
+			case maximize:
+				a[i][j] = -c
+			default:
+				a[i][j] = c
+			}
+		}
+	}
+
+	for i, j := range hungarian(a) {
+		r, c := i, j
+		if transposed {
+			r, c = j, i
+		}
+		if math.IsInf(cost[r][c], 0) {
+			continue // forbidden pairs are not assigned
+		}
+		assignment[r] = c
+		total += cost[r][c]
+	}
+
+	return assignment, total
+}
+
+// hungarian returns the column assigned to each row
+// minimizing the total cost of the n x m matrix a, with n <= m.
+//
+// Rows are added one by one: each new row is assigned through
+// a shortest augmenting path with respect to reduced costs,
+// maintained thanks to row and column potentials.
+func hungarian(a [][]float64) []int {
+	n, m := len(a), len(a[0])
+
+	// rows and columns are numbered from 1, column 0 is a fictitious column
+	u := make([]float64, n+1) // row potentials
+	v := make([]float64, m+1) // column potentials
+	p := make([]int, m+1)     // row assigned to each column, 0 if none
+	way := make([]int, m+1)   // previous column on the augmenting path
+	minv := make([]float64, m+1)
+	used := make([]bool, m+1)
+
+	for i := 1; i <= n; i++ {
+		// look for an augmenting path from row i
+		p[0] = i
+		j0 := 0
+		for j := range minv {
+			minv[j] = math.Inf(1)
+			used[j] = false
+		}
+		for p[j0] != 0 {
+			used[j0] = true
+			i0 := p[j0]
+			delta := math.Inf(1)
+			j1 := 0
+			for j := 1; j <= m; j++ {
+				if used[j] {
+					continue
+				}
+				if cur := a[i0-1][j-1] - u[i0] - v[j]; cur < minv[j] {
+					minv[j] = cur
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+			for j := 0; j <= m; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+		}
+
+		// augment along the path
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	assignment := make([]int, n)
+	for j := 1; j <= m; j++ {
+		if p[j] != 0 {
+			assignment[p[j]-1] = j - 1
+		}
+	}
+	return assignment
+}
+
+// Hungarian solves the assignment problem on a weighted bipartite graph.
+// left is the list of vertices of one side of the graph:
+// NextVertices must return vertices of the other side for these vertices.
+// NextVertices is not called on the other side, so g can be directed from left to right.
+// The weight of an edge is the cost of assigning its vertices to each other
+// and missing edges are forbidden pairs.
+//
+// As many left vertices as possible are assigned to distinct vertices of the other side.
+// Among such assignments, the one with minimum total weight is returned,
+// or the one with maximum total weight if maximize is true.
+// It returns the vertex assigned to each assigned left vertex and the total weight.
+//
+// See HungarianMatrix for details.
+//
+// The slice returned by calls to NextVertices is never modified.
+// So there is no risk of accidentally modifying g.
+func Hungarian(g WeightForward, left []string, maximize bool) (assignment map[string]string, total float64) {
+	// index right vertices
+	index := make(map[string]int)
+	var right []string
+	for _, u := range left {
+		for _, w := range g.NextVertices(u) {
+			if _, found := index[w]; !found {
+				index[w] = len(right)
+				right = append(right, w)
+			}
+		}
+	}
+
+	// dense cost matrix, missing edges are forbidden
+	cost := make([][]float64, len(left))
+	for i, u := range left {
+		cost[i] = make([]float64, len(right))
+		for j := range cost[i] {
+			cost[i][j] = math.Inf(1)
+		}
+		for _, w := range g.NextVertices(u) {
+			cost[i][index[w]] = g.Weight(u, w)
+		}
+	}
+
+	rowToCol, total := HungarianMatrix(cost, maximize)
+	assignment = make(map[string]string)
+	for i, j := range rowToCol {
+		if j >= 0 {
+			assignment[left[i]] = right[j]
+		}
+	}
+	return assignment, total
+}
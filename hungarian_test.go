@@ -0,0 +1,86 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// bestAssignment exhaustively looks for the best assignment of rows i and next ones.
+// It returns the number of assigned rows and the total cost.
+func bestAssignment(cost [][]float64, i int, used []bool, maximize bool) (int, float64) {
+	if i == len(cost) {
+		return 0, 0
+	}
+
+	// row i is not assigned
+	bestCount, bestTotal := bestAssignment(cost, i+1, used, maximize)
+
+	// row i is assigned to column j
+	for j, c := range cost[i] {
+		if used[j] || math.IsInf(c, 0) {
+			continue
+		}
+		used[j] = true
+		count, total := bestAssignment(cost, i+1, used, maximize)
+		used[j] = false
+		count, total = count+1, total+c
+
+		better := total < bestTotal
+		if maximize {
+			better = total > bestTotal
+		}
+		if count > bestCount || (count == bestCount && better) {
+			bestCount, bestTotal = count, total
+		}
+	}
+	return bestCount, bestTotal
+}
+
+// TestHungarianMatrix compares the assignments found on random matrices
+// with an exhaustive search.
+func TestHungarianMatrix(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 500; trial++ {
+		rows, cols := 1+rnd.Intn(6), 1+rnd.Intn(6)
+		cost := make([][]float64, rows)
+		for i := range cost {
+			cost[i] = make([]float64, cols)
+			for j := range cost[i] {
+				if rnd.Intn(4) == 0 {
+					cost[i][j] = math.Inf(1)
+				} else {
+					cost[i][j] = float64(rnd.Intn(20) - 5)
+				}
+			}
+		}
+
+		for _, maximize := range []bool{false, true} {
+			assignment, total := HungarianMatrix(cost, maximize)
+			expectedCount, expectedTotal := bestAssignment(cost, 0, make([]bool, cols), maximize)
+
+			// check the assignment
+			count := 0
+			var sum float64
+			used := make(map[int]bool)
+			for i, j := range assignment {
+				if j < 0 {
+					continue
+				}
+				if used[j] || math.IsInf(cost[i][j], 0) {
+					t.Errorf("trial %d: invalid assignment %v", trial, assignment)
+				}
+				used[j] = true
+				count++
+				sum += cost[i][j]
+			}
+			if sum != total {
+				t.Errorf("trial %d: total is %v instead of %v", trial, total, sum)
+			}
+			if count != expectedCount || total != expectedTotal {
+				t.Errorf("trial %d, maximize %v: %d rows assigned with total %v instead of %d with %v",
+					trial, maximize, count, total, expectedCount, expectedTotal)
+			}
+		}
+	}
+}
@@ -24,3 +24,56 @@ type WeightForward interface {
 	// Weight return the weight of the edge.
 	Weight(from, to string) float64
 }
+
+// EdgeForward is the interface allowing to navigate forward through the edges of a graph.
+// Unlike Forward and WeightForward, it can represent multigraphs,
+// i.e. graphs with several edges between two vertices.
+type EdgeForward interface {
+	// OutEdges returns the list of edges leaving the vertex v.
+	OutEdges(v string) []Edge
+}
+
+// VertexListEdgeForward is an EdgeForward graph
+// whose vertices can be listed.
+type VertexListEdgeForward interface {
+	EdgeForward
+
+	// Vertices returns the list of vertices of the graph.
+	Vertices() []string
+}
+
+// VertexListWeightForward is a WeightForward graph
+// whose vertices can be listed.
+type VertexListWeightForward interface {
+	VertexListForward
+
+	// Weight return the weight of the edge.
+	Weight(from, to string) float64
+}
+
+// CapacityForward is a Forward graph
+// with float64 capacities on its edges.
+type CapacityForward interface {
+	Forward
+
+	// Capacity returns the capacity of the edge.
+	Capacity(from, to string) float64
+}
+
+// VertexListCapacityForward is a CapacityForward graph
+// whose vertices can be listed.
+type VertexListCapacityForward interface {
+	VertexListForward
+
+	// Capacity returns the capacity of the edge.
+	Capacity(from, to string) float64
+}
+
+// CostCapacityForward is a CapacityForward graph
+// with a cost per unit of flow on its edges.
+type CostCapacityForward interface {
+	CapacityForward
+
+	// Cost returns the cost of sending one unit of flow through the edge.
+	Cost(from, to string) float64
+}
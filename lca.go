@@ -0,0 +1,196 @@
+package graph
+
+// Ancestors answers lowest common ancestor queries on a rooted forest.
+//
+// It uses binary lifting:
+// the 2^k-th ancestor of each vertex is precomputed in O(n log n) time and space,
+// so that queries run in O(log n) time.
+type Ancestors struct {
+	index map[string]int
+	names []string
+	depth []int
+	root  []int
+	up    [][]int // up[k][i] is the 2^k-th ancestor of vertex i, or its root
+}
+
+// AncestorsFromTree builds the ancestor structure of the tree rooted at the root vertex.
+// If g is not a tree, the breadth-first search tree of the vertices reachable from the root is used.
+//
+// The slice returned by calls to NextVertices is never modified.
+// So there is no risk of accidentally modifying g.
+func AncestorsFromTree(g Forward, root string) *Ancestors {
+	vis := newAncestorVisitor()
+	BreadthFirstVisit(g, vis, root)
+	return vis.ancestors()
+}
+
+// AncestorsFromParents builds the ancestor structure of the forest given by the parent of each vertex,
+// such as the predecessor map recorded by a visitor on TreeEdge events.
+// Vertices without parent, or which are their own parent, are roots.
+// Vertices on a cycle of the parent map, and their descendants, are ignored.
+func AncestorsFromParents(parent map[string]string) *Ancestors {
+	children := make(map[string][]string)
+	var roots []string
+	for v, p := range parent {
+		if p == v {
+			roots = append(roots, v)
+			continue
+		}
+		children[p] = append(children[p], v)
+		if _, found := parent[p]; !found && len(children[p]) == 1 {
+			roots = append(roots, p)
+		}
+	}
+
+	vis := newAncestorVisitor()
+	forest := &adjacencyList{next: children}
+	for _, root := range roots {
+		BreadthFirstVisit(forest, vis, root)
+	}
+	return vis.ancestors()
+}
+
+// LCA returns the lowest common ancestor of u and v,
+// i.e. their deepest common ancestor, a vertex being an ancestor of itself.
+// It returns false if u and v are not in the same tree.
+func (a *Ancestors) LCA(u, v string) (string, bool) {
+	l, found := a.lca(u, v)
+	if !found {
+		return "", false
+	}
+	return a.names[l], true
+}
+
+// Distance returns the number of edges of the tree path between u and v.
+// It returns false if u and v are not in the same tree.
+func (a *Ancestors) Distance(u, v string) (int, bool) {
+	l, found := a.lca(u, v)
+	if !found {
+		return 0, false
+	}
+	return a.depth[a.index[u]] + a.depth[a.index[v]] - 2*a.depth[l], true
+}
+
+// Depth returns the number of edges between v and the root of its tree.
+// It returns false if v is not in the forest.
+func (a *Ancestors) Depth(v string) (int, bool) {
+	i, found := a.index[v]
+	if !found {
+		return 0, false
+	}
+	return a.depth[i], true
+}
+
+// Ancestor returns the k-th ancestor of v: its parent for k equal to one, v itself for k equal to zero.
+// It returns false if v is not in the forest or if its depth is less than k.
+func (a *Ancestors) Ancestor(v string, k int) (string, bool) {
+	i, found := a.index[v]
+	if !found || k < 0 || k > a.depth[i] {
+		return "", false
+	}
+	return a.names[a.lift(i, k)], true
+}
+
+// lift returns the k-th ancestor of vertex i.
+func (a *Ancestors) lift(i, k int) int {
+	for level := 0; k != 0; level++ {
+		if k&1 == 1 {
+			i = a.up[level][i]
+		}
+		k >>= 1
+	}
+	return i
+}
+
+// lca returns the index of the lowest common ancestor of u and v.
+func (a *Ancestors) lca(u, v string) (int, bool) {
+	i, okU := a.index[u]
+	j, okV := a.index[v]
+	if !okU || !okV || a.root[i] != a.root[j] {
+		return 0, false
+	}
+
+	// bring both vertices to the same depth
+	if a.depth[i] < a.depth[j] {
+		i, j = j, i
+	}
+	i = a.lift(i, a.depth[i]-a.depth[j])
+	if i == j {
+		return i, true
+	}
+
+	// climb as long as the ancestors differ
+	for level := len(a.up) - 1; level >= 0; level-- {
+		if a.up[level][i] != a.up[level][j] {
+			i, j = a.up[level][i], a.up[level][j]
+		}
+	}
+	return a.up[0][i], true
+}
+
+// ancestorVisitor is a BfsVisitor recording the search trees.
+type ancestorVisitor struct {
+	a      *Ancestors
+	parent []int
+}
+
+func newAncestorVisitor() *ancestorVisitor {
+	return &ancestorVisitor{
+		a: &Ancestors{index: make(map[string]int)},
+	}
+}
+
+func (vis *ancestorVisitor) ExamineVertex(string)       {}
+func (vis *ancestorVisitor) ExamineEdge(string, string) {}
+func (vis *ancestorVisitor) NonTreeEdge(string, string) {}
+func (vis *ancestorVisitor) GrayTarget(string, string)  {}
+func (vis *ancestorVisitor) BlackTarget(string, string) {}
+func (vis *ancestorVisitor) FinishVertex(string)        {}
+
+func (vis *ancestorVisitor) DiscoverVertex(v string) {
+	if _, found := vis.a.index[v]; !found {
+		// root of a new search tree
+		i := vis.add(v)
+		vis.parent = append(vis.parent, i)
+		vis.a.depth = append(vis.a.depth, 0)
+		vis.a.root = append(vis.a.root, i)
+	}
+}
+
+func (vis *ancestorVisitor) TreeEdge(from, to string) {
+	p := vis.a.index[from]
+	vis.add(to)
+	vis.parent = append(vis.parent, p)
+	vis.a.depth = append(vis.a.depth, vis.a.depth[p]+1)
+	vis.a.root = append(vis.a.root, vis.a.root[p])
+}
+
+// add indexes a new vertex.
+func (vis *ancestorVisitor) add(v string) int {
+	i := len(vis.a.names)
+	vis.a.index[v] = i
+	vis.a.names = append(vis.a.names, v)
+	return i
+}
+
+// ancestors builds the binary lifting table of the recorded trees.
+func (vis *ancestorVisitor) ancestors() *Ancestors {
+	a := vis.a
+	maxDepth := 0
+	for _, d := range a.depth {
+		if d > maxDepth {
+			maxDepth = d
+		}
+	}
+
+	a.up = [][]int{vis.parent}
+	for 1<<uint(len(a.up)) <= maxDepth {
+		previous := a.up[len(a.up)-1]
+		level := make([]int, len(previous))
+		for i, p := range previous {
+			level[i] = previous[p]
+		}
+		a.up = append(a.up, level)
+	}
+	return a
+}
@@ -0,0 +1,99 @@
+package graph
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+// pathToRoot returns the vertices from v to the root of its tree in the parent map.
+func pathToRoot(parent map[string]string, v string) []string {
+	path := []string{v}
+	for p, found := parent[v]; found && p != v; p, found = parent[v] {
+		v = p
+		path = append(path, v)
+	}
+	return path
+}
+
+// TestAncestors compares lowest common ancestors in random forests
+// with a naive walk to the roots.
+func TestAncestors(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 100; trial++ {
+		n := 1 + rnd.Intn(40)
+		parent := make(map[string]string)
+		for i := 1; i < n; i++ {
+			if rnd.Intn(5) != 0 {
+				parent[strconv.Itoa(i)] = strconv.Itoa(rnd.Intn(i))
+			} else if rnd.Intn(2) == 0 {
+				parent[strconv.Itoa(i)] = strconv.Itoa(i)
+			}
+		}
+		a := AncestorsFromParents(parent)
+
+		// vertices which are neither a key nor a value are unknown
+		known := make(map[string]bool)
+		for v, p := range parent {
+			known[v], known[p] = true, true
+		}
+
+		for i := 0; i < n; i++ {
+			u := strconv.Itoa(i)
+			if !known[u] {
+				if _, found := a.Depth(u); found {
+					t.Errorf("trial %d: unknown vertex %s found", trial, u)
+				}
+				continue
+			}
+			up := pathToRoot(parent, u)
+			if depth, _ := a.Depth(u); depth != len(up)-1 {
+				t.Errorf("trial %d: depth of %s is %d instead of %d", trial, u, depth, len(up)-1)
+			}
+			for k, expected := range up {
+				if ancestor, _ := a.Ancestor(u, k); ancestor != expected {
+					t.Errorf("trial %d: ancestor %d of %s is %s instead of %s", trial, k, u, ancestor, expected)
+				}
+			}
+			if _, found := a.Ancestor(u, len(up)); found {
+				t.Errorf("trial %d: %s has too many ancestors", trial, u)
+			}
+
+			for j := 0; j < n; j++ {
+				v := strconv.Itoa(j)
+				if !known[v] {
+					continue
+				}
+				upV := pathToRoot(parent, v)
+				onPath := make(map[string]int)
+				for d, w := range up {
+					onPath[w] = d
+				}
+				expected, distance, found := "", 0, false
+				for d, w := range upV {
+					if du, common := onPath[w]; common {
+						expected, distance, found = w, du+d, true
+						break
+					}
+				}
+
+				lca, ok := a.LCA(u, v)
+				if ok != found || lca != expected {
+					t.Errorf("trial %d: LCA of %s and %s is %s instead of %s", trial, u, v, lca, expected)
+				}
+				if d, _ := a.Distance(u, v); d != distance {
+					t.Errorf("trial %d: distance between %s and %s is %d instead of %d", trial, u, v, d, distance)
+				}
+			}
+		}
+	}
+
+	// vertices on a cycle are ignored
+	a := AncestorsFromParents(map[string]string{"a": "b", "b": "a", "c": "d"})
+	if _, found := a.Depth("a"); found {
+		t.Error("vertex on a cycle should be ignored")
+	}
+	if lca, _ := a.LCA("c", "d"); lca != "d" {
+		t.Errorf("LCA of c and d is %s instead of d", lca)
+	}
+}
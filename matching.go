@@ -0,0 +1,701 @@
+package graph
+
+// MaximumMatching computes a maximum cardinality matching of an undirected graph,
+// i.e. a largest set of edges without common vertices.
+// Each edge may be listed in one direction or in both; self-loops are ignored.
+// Edges leading to vertices which are not listed by Vertices are ignored.
+//
+// It returns the matching, mapping each matched vertex to its mate:
+// both vertices of a matched edge are keys of the map.
+//
+// It uses Edmonds' blossom algorithm and runs in O(V^3) time.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func MaximumMatching(g VertexListForward) map[string]string {
+	return maximumWeightMatching(g, func(string, string) float64 { return 1 }, false)
+}
+
+// MaximumWeightMatching computes a matching of an undirected graph with maximum total weight.
+// If maxCardinality is true, it computes a matching with maximum total weight
+// among the matchings with maximum cardinality.
+// Each edge may be listed in one direction or in both; self-loops are ignored.
+// Edges leading to vertices which are not listed by Vertices are ignored.
+//
+// It returns the matching, mapping each matched vertex to its mate:
+// both vertices of a matched edge are keys of the map.
+//
+// It uses the primal-dual version of Edmonds' blossom algorithm and runs in O(V^3) time.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func MaximumWeightMatching(g VertexListWeightForward, maxCardinality bool) map[string]string {
+	return maximumWeightMatching(g, g.Weight, maxCardinality)
+}
+
+func maximumWeightMatching(g VertexListForward, weight func(from, to string) float64, maxCardinality bool) map[string]string {
+	// index vertices and list undirected edges once
+	vertices := g.Vertices()
+	index := make(map[string]int, len(vertices))
+	for i, v := range vertices {
+		index[v] = i
+	}
+	var edges []weightedEdge
+	listed := make(map[[2]int]bool)
+	for i, v := range vertices {
+		for _, w := range g.NextVertices(v) {
+			j, found := index[w]
+			if !found || i == j || listed[[2]int{i, j}] || listed[[2]int{j, i}] {
+				continue
+			}
+			listed[[2]int{i, j}] = true
+			edges = append(edges, weightedEdge{i, j, weight(v, w)})
+		}
+	}
+
+	mate := newWeightedBlossom(len(vertices), edges).solve(maxCardinality)
+
+	matching := make(map[string]string)
+	for i, j := range mate {
+		if j >= 0 {
+			matching[vertices[i]] = vertices[j]
+		}
+	}
+	return matching
+}
+
+// weightedEdge is an undirected edge between indexed vertices.
+type weightedEdge struct {
+	i, j int
+	w    float64
+}
+
+// weightedBlossom holds the state of the primal-dual blossom algorithm.
+//
+// Vertices are numbered from 0 to n-1 and non-trivial blossoms from n to 2n-1.
+// Edge k has endpoints 2k and 2k+1:
+// endpoint p is vertex endpoint[p] and the other end of the edge is endpoint p^1.
+type weightedBlossom struct {
+	n         int
+	edges     []weightedEdge
+	endpoint  []int   // vertex of each endpoint
+	neighbend [][]int // remote endpoints of the edges of each vertex
+
+	// mate[v] is the remote endpoint of the matched edge of vertex v, -1 if single
+	mate []int
+
+	// labels of top-level blossoms and vertices:
+	// 0 for unlabelled, 1 for S and 2 for T
+	label []int
+	// endpoint through which a blossom or vertex got its label, -1 if none
+	labelend []int
+
+	inblossom        []int     // top-level blossom of each vertex
+	blossomparent    []int     // parent blossom, -1 for top-level blossoms
+	blossomchilds    [][]int   // sub-blossoms, starting with the base sub-blossom
+	blossombase      []int     // base vertex of each blossom, -1 for unused blossoms
+	blossomendps     [][]int   // endpoints linking sub-blossoms
+	bestedge         []int     // least slack edge to a different S-blossom, -1 if none
+	blossombestedges [][]int   // least slack edges to S-blossoms, nil if not computed
+	unusedblossoms   []int     // available blossom numbers
+	dualvar          []float64 // dual variables of vertices and blossoms
+	allowedge        []bool    // edges with zero slack
+	queue            []int     // S-vertices to scan
+}
+
+func newWeightedBlossom(n int, edges []weightedEdge) *weightedBlossom {
+	m := &weightedBlossom{
+		n:                n,
+		edges:            edges,
+		endpoint:         make([]int, 2*len(edges)),
+		neighbend:        make([][]int, n),
+		mate:             make([]int, n),
+		label:            make([]int, 2*n),
+		labelend:         make([]int, 2*n),
+		inblossom:        make([]int, n),
+		blossomparent:    make([]int, 2*n),
+		blossomchilds:    make([][]int, 2*n),
+		blossombase:      make([]int, 2*n),
+		blossomendps:     make([][]int, 2*n),
+		bestedge:         make([]int, 2*n),
+		blossombestedges: make([][]int, 2*n),
+		dualvar:          make([]float64, 2*n),
+		allowedge:        make([]bool, len(edges)),
+	}
+
+	var maxWeight float64
+	for k, e := range edges {
+		m.endpoint[2*k] = e.i
+		m.endpoint[2*k+1] = e.j
+		m.neighbend[e.i] = append(m.neighbend[e.i], 2*k+1)
+		m.neighbend[e.j] = append(m.neighbend[e.j], 2*k)
+		if e.w > maxWeight {
+			maxWeight = e.w
+		}
+	}
+	for v := 0; v < n; v++ {
+		m.mate[v] = -1
+		m.inblossom[v] = v
+		m.blossombase[v] = v
+		m.blossombase[n+v] = -1
+		m.dualvar[v] = maxWeight
+		m.unusedblossoms = append(m.unusedblossoms, n+v)
+	}
+	for b := range m.labelend {
+		m.labelend[b] = -1
+		m.blossomparent[b] = -1
+		m.bestedge[b] = -1
+	}
+
+	return m
+}
+
+// slack returns the slack of edge k.
+func (m *weightedBlossom) slack(k int) float64 {
+	e := m.edges[k]
+	return m.dualvar[e.i] + m.dualvar[e.j] - 2*e.w
+}
+
+// leaves returns the vertices of blossom b.
+func (m *weightedBlossom) leaves(b int) []int {
+	if b < m.n {
+		return []int{b}
+	}
+	var leaves []int
+	for _, t := range m.blossomchilds[b] {
+		leaves = append(leaves, m.leaves(t)...)
+	}
+	return leaves
+}
+
+// assignLabel labels vertex w and its top-level blossom with t,
+// coming through endpoint p.
+// The mate of a T-blossom base is labelled S.
+func (m *weightedBlossom) assignLabel(w, t, p int) {
+	b := m.inblossom[w]
+	m.label[w], m.label[b] = t, t
+	m.labelend[w], m.labelend[b] = p, p
+	m.bestedge[w], m.bestedge[b] = -1, -1
+	if t == 1 {
+		m.queue = append(m.queue, m.leaves(b)...)
+	} else if t == 2 {
+		base := m.blossombase[b]
+		m.assignLabel(m.endpoint[m.mate[base]], 1, m.mate[base]^1)
+	}
+}
+
+// scanBlossom traces back from S-vertices v and w to discover either a new blossom or an augmenting path.
+// It returns the base vertex of the new blossom or -1.
+func (m *weightedBlossom) scanBlossom(v, w int) int {
+	var path []int
+	base := -1
+	for v != -1 || w != -1 {
+		b := m.inblossom[v]
+		if m.label[b]&4 != 0 {
+			base = m.blossombase[b]
+			break
+		}
+		path = append(path, b)
+		m.label[b] = 5 // mark as visited
+		if m.labelend[b] == -1 {
+			v = -1 // reached a single vertex
+		} else {
+			v = m.endpoint[m.labelend[b]]
+			b = m.inblossom[v]
+			v = m.endpoint[m.labelend[b]]
+		}
+		// swap v and w to alternate between both paths
+		if w != -1 {
+			v, w = w, v
+		}
+	}
+	for _, b := range path {
+		m.label[b] = 1
+	}
+	return base
+}
+
+// addBlossom creates a new blossom with the given base vertex
+// from the S-vertices linked by edge k.
+func (m *weightedBlossom) addBlossom(base, k int) {
+	v, w := m.edges[k].i, m.edges[k].j
+	bb := m.inblossom[base]
+	bv := m.inblossom[v]
+	bw := m.inblossom[w]
+
+	b := m.unusedblossoms[len(m.unusedblossoms)-1]
+	m.unusedblossoms = m.unusedblossoms[:len(m.unusedblossoms)-1]
+	m.blossombase[b] = base
+	m.blossomparent[b] = -1
+	m.blossomparent[bb] = b
+
+	// list sub-blossoms from the base to v and then from w back to the base
+	var path, endps []int
+	for bv != bb {
+		m.blossomparent[bv] = b
+		path = append(path, bv)
+		endps = append(endps, m.labelend[bv])
+		v = m.endpoint[m.labelend[bv]]
+		bv = m.inblossom[v]
+	}
+	path = append(path, bb)
+	reverseInts(path)
+	reverseInts(endps)
+	endps = append(endps, 2*k)
+	for bw != bb {
+		m.blossomparent[bw] = b
+		path = append(path, bw)
+		endps = append(endps, m.labelend[bw]^1)
+		w = m.endpoint[m.labelend[bw]]
+		bw = m.inblossom[w]
+	}
+	m.blossomchilds[b] = path
+	m.blossomendps[b] = endps
+
+	// the new blossom is an S-blossom
+	m.label[b] = 1
+	m.labelend[b] = m.labelend[bb]
+	m.dualvar[b] = 0
+	for _, v := range m.leaves(b) {
+		if m.label[m.inblossom[v]] == 2 {
+			// former T-vertices become S-vertices
+			m.queue = append(m.queue, v)
+		}
+		m.inblossom[v] = b
+	}
+
+	// compute the least slack edges to neighbouring S-blossoms
+	bestedgeto := make([]int, 2*m.n)
+	for i := range bestedgeto {
+		bestedgeto[i] = -1
+	}
+	for _, bv := range path {
+		var nblists [][]int
+		if m.blossombestedges[bv] == nil {
+			for _, v := range m.leaves(bv) {
+				nblist := make([]int, len(m.neighbend[v]))
+				for i, p := range m.neighbend[v] {
+					nblist[i] = p / 2
+				}
+				nblists = append(nblists, nblist)
+			}
+		} else {
+			nblists = [][]int{m.blossombestedges[bv]}
+		}
+		for _, nblist := range nblists {
+			for _, k := range nblist {
+				// j is the endpoint outside of the new blossom
+				j := m.edges[k].j
+				if m.inblossom[j] == b {
+					j = m.edges[k].i
+				}
+				bj := m.inblossom[j]
+				if bj != b && m.label[bj] == 1 && (bestedgeto[bj] == -1 || m.slack(k) < m.slack(bestedgeto[bj])) {
+					bestedgeto[bj] = k
+				}
+			}
+		}
+		m.blossombestedges[bv] = nil
+		m.bestedge[bv] = -1
+	}
+	best := make([]int, 0, len(bestedgeto))
+	for _, k := range bestedgeto {
+		if k != -1 {
+			best = append(best, k)
+		}
+	}
+	m.blossombestedges[b] = best
+	m.bestedge[b] = -1
+	for _, k := range best {
+		if m.bestedge[b] == -1 || m.slack(k) < m.slack(m.bestedge[b]) {
+			m.bestedge[b] = k
+		}
+	}
+}
+
+// expandBlossom turns the sub-blossoms of blossom b into top-level blossoms.
+// At the end of a stage, sub-blossoms with a zero dual variable are recursively expanded.
+func (m *weightedBlossom) expandBlossom(b int, endstage bool) {
+	for _, s := range m.blossomchilds[b] {
+		m.blossomparent[s] = -1
+		if s < m.n {
+			m.inblossom[s] = s
+		} else if endstage && m.dualvar[s] == 0 {
+			m.expandBlossom(s, endstage)
+		} else {
+			for _, v := range m.leaves(s) {
+				m.inblossom[v] = s
+			}
+		}
+	}
+
+	// relabel the sub-blossoms of an expanded T-blossom
+	if !endstage && m.label[b] == 2 {
+		childs, endps := m.blossomchilds[b], m.blossomendps[b]
+		entrychild := m.inblossom[m.endpoint[m.labelend[b]^1]]
+
+		// go from the entry child to the base along the even length path
+		j := indexOf(childs, entrychild)
+		var jstep, endptrick int
+		if j&1 != 0 {
+			j -= len(childs)
+			jstep, endptrick = 1, 0
+		} else {
+			jstep, endptrick = -1, 1
+		}
+		p := m.labelend[b]
+		for j != 0 {
+			// relabel the T-sub-blossom
+			m.label[m.endpoint[p^1]] = 0
+			m.label[m.endpoint[at(endps, j-endptrick)^endptrick^1]] = 0
+			m.assignLabel(m.endpoint[p^1], 2, p)
+			// next S-sub-blossom and T-sub-blossom edges are allowed
+			m.allowedge[at(endps, j-endptrick)/2] = true
+			j += jstep
+			p = at(endps, j-endptrick) ^ endptrick
+			m.allowedge[p/2] = true
+			j += jstep
+		}
+
+		// the base sub-blossom becomes a T-blossom
+		bv := at(childs, j)
+		m.label[m.endpoint[p^1]], m.label[bv] = 2, 2
+		m.labelend[m.endpoint[p^1]], m.labelend[bv] = p, p
+		m.bestedge[bv] = -1
+
+		// other sub-blossoms may be reachable from neighbouring S-vertices
+		j += jstep
+		for at(childs, j) != entrychild {
+			bv := at(childs, j)
+			if m.label[bv] == 1 {
+				j += jstep
+				continue
+			}
+			var v int
+			for _, v = range m.leaves(bv) {
+				if m.label[v] != 0 {
+					break
+				}
+			}
+			if m.label[v] != 0 {
+				m.label[v] = 0
+				m.label[m.endpoint[m.mate[m.blossombase[bv]]]] = 0
+				m.assignLabel(v, 2, m.labelend[v])
+			}
+			j += jstep
+		}
+	}
+
+	// recycle blossom number
+	m.label[b], m.labelend[b] = -1, -1
+	m.blossomchilds[b], m.blossomendps[b] = nil, nil
+	m.blossombase[b] = -1
+	m.blossombestedges[b] = nil
+	m.bestedge[b] = -1
+	m.unusedblossoms = append(m.unusedblossoms, b)
+}
+
+// augmentBlossom swaps matched and unmatched edges inside blossom b
+// along the path from vertex v to the base, so that v becomes the base.
+func (m *weightedBlossom) augmentBlossom(b, v int) {
+	// sub-blossom of b containing v
+	t := v
+	for m.blossomparent[t] != b {
+		t = m.blossomparent[t]
+	}
+	if t >= m.n {
+		m.augmentBlossom(t, v)
+	}
+
+	// go from t to the base along the even length path
+	childs, endps := m.blossomchilds[b], m.blossomendps[b]
+	i := indexOf(childs, t)
+	j := i
+	var jstep, endptrick int
+	if i&1 != 0 {
+		j -= len(childs)
+		jstep, endptrick = 1, 0
+	} else {
+		jstep, endptrick = -1, 1
+	}
+	for j != 0 {
+		j += jstep
+		t = at(childs, j)
+		p := at(endps, j-endptrick) ^ endptrick
+		if t >= m.n {
+			m.augmentBlossom(t, m.endpoint[p])
+		}
+		j += jstep
+		t = at(childs, j)
+		if t >= m.n {
+			m.augmentBlossom(t, m.endpoint[p^1])
+		}
+		// match the edge between both sub-blossoms
+		m.mate[m.endpoint[p]] = p ^ 1
+		m.mate[m.endpoint[p^1]] = p
+	}
+
+	// rotate sub-blossoms so that the new base comes first
+	m.blossomchilds[b] = append(append([]int(nil), childs[i:]...), childs[:i]...)
+	m.blossomendps[b] = append(append([]int(nil), endps[i:]...), endps[:i]...)
+	m.blossombase[b] = m.blossombase[m.blossomchilds[b][0]]
+}
+
+// augmentMatching swaps matched and unmatched edges
+// along the augmenting path through edge k between two S-vertices.
+func (m *weightedBlossom) augmentMatching(k int) {
+	for _, start := range [2][2]int{{m.edges[k].i, 2*k + 1}, {m.edges[k].j, 2 * k}} {
+		s, p := start[0], start[1]
+		for {
+			bs := m.inblossom[s]
+			if bs >= m.n {
+				m.augmentBlossom(bs, s)
+			}
+			m.mate[s] = p
+			if m.labelend[bs] == -1 {
+				break // reached a single vertex
+			}
+
+			// trace back through the T-blossom
+			t := m.endpoint[m.labelend[bs]]
+			bt := m.inblossom[t]
+			s = m.endpoint[m.labelend[bt]]
+			j := m.endpoint[m.labelend[bt]^1]
+			if bt >= m.n {
+				m.augmentBlossom(bt, j)
+			}
+			m.mate[j] = m.labelend[bt]
+			p = m.labelend[bt] ^ 1
+		}
+	}
+}
+
+// solve computes the matching.
+// It returns the mate of each vertex, -1 for single vertices.
+func (m *weightedBlossom) solve(maxCardinality bool) []int {
+	n := m.n
+
+	// each stage augments the matching once, or stops
+	for stage := 0; stage < n; stage++ {
+		for b := range m.label {
+			m.label[b] = 0
+			m.bestedge[b] = -1
+		}
+		for b := n; b < 2*n; b++ {
+			m.blossombestedges[b] = nil
+		}
+		for k := range m.allowedge {
+			m.allowedge[k] = false
+		}
+		m.queue = m.queue[:0]
+
+		// single vertices are S-vertices
+		for v := 0; v < n; v++ {
+			if m.mate[v] == -1 && m.label[m.inblossom[v]] == 0 {
+				m.assignLabel(v, 1, -1)
+			}
+		}
+
+		augmented := false
+	substage:
+		for {
+			// grow alternating trees from S-vertices along tight edges
+			for len(m.queue) > 0 && !augmented {
+				v := m.queue[len(m.queue)-1]
+				m.queue = m.queue[:len(m.queue)-1]
+
+				for _, p := range m.neighbend[v] {
+					k := p / 2
+					w := m.endpoint[p]
+					if m.inblossom[v] == m.inblossom[w] {
+						continue // internal edge
+					}
+					var kslack float64
+					if !m.allowedge[k] {
+						kslack = m.slack(k)
+						if kslack <= 0 {
+							m.allowedge[k] = true
+						}
+					}
+
+					if m.allowedge[k] {
+						if m.label[m.inblossom[w]] == 0 {
+							// w becomes a T-vertex and its mate an S-vertex
+							m.assignLabel(w, 2, p^1)
+						} else if m.label[m.inblossom[w]] == 1 {
+							// either a new blossom or an augmenting path
+							if base := m.scanBlossom(v, w); base >= 0 {
+								m.addBlossom(base, k)
+							} else {
+								m.augmentMatching(k)
+								augmented = true
+								break
+							}
+						} else if m.label[w] == 0 {
+							// w is inside a T-blossom but has not been reached yet
+							m.label[w] = 2
+							m.labelend[w] = p ^ 1
+						}
+					} else if m.label[m.inblossom[w]] == 1 {
+						// keep track of the least slack edge between S-blossoms
+						b := m.inblossom[v]
+						if m.bestedge[b] == -1 || kslack < m.slack(m.bestedge[b]) {
+							m.bestedge[b] = k
+						}
+					} else if m.label[w] == 0 {
+						// keep track of the least slack edge to unreached vertices
+						if m.bestedge[w] == -1 || kslack < m.slack(m.bestedge[w]) {
+							m.bestedge[w] = k
+						}
+					}
+				}
+			}
+			if augmented {
+				break
+			}
+
+			// no progress possible: update dual variables
+			deltatype := -1
+			var delta float64
+			deltaedge, deltablossom := -1, -1
+
+			// minimum dual variable of vertices
+			if !maxCardinality {
+				deltatype = 1
+				delta = m.minVertexDual()
+			}
+			// minimum slack of edges between S-vertices and free vertices
+			for v := 0; v < n; v++ {
+				if m.label[m.inblossom[v]] == 0 && m.bestedge[v] != -1 {
+					if d := m.slack(m.bestedge[v]); deltatype == -1 || d < delta {
+						delta = d
+						deltatype = 2
+						deltaedge = m.bestedge[v]
+					}
+				}
+			}
+			// half minimum slack of edges between S-blossoms
+			for b := 0; b < 2*n; b++ {
+				if m.blossomparent[b] == -1 && m.label[b] == 1 && m.bestedge[b] != -1 {
+					if d := m.slack(m.bestedge[b]) / 2; deltatype == -1 || d < delta {
+						delta = d
+						deltatype = 3
+						deltaedge = m.bestedge[b]
+					}
+				}
+			}
+			// minimum dual variable of T-blossoms
+			for b := n; b < 2*n; b++ {
+				if m.blossombase[b] >= 0 && m.blossomparent[b] == -1 && m.label[b] == 2 &&
+					(deltatype == -1 || m.dualvar[b] < delta) {
+					delta = m.dualvar[b]
+					deltatype = 4
+					deltablossom = b
+				}
+			}
+			if deltatype == -1 {
+				// no further improvement is possible with maximum cardinality:
+				// do a final delta update to make the optimum verifiable
+				deltatype = 1
+				delta = m.minVertexDual()
+				if delta < 0 {
+					delta = 0
+				}
+			}
+
+			for v := 0; v < n; v++ {
+				switch m.label[m.inblossom[v]] {
+				case 1:
+					m.dualvar[v] -= delta
+				case 2:
+					m.dualvar[v] += delta
+				}
+			}
+			for b := n; b < 2*n; b++ {
+				if m.blossombase[b] >= 0 && m.blossomparent[b] == -1 {
+					switch m.label[b] {
+					case 1:
+						m.dualvar[b] += delta
+					case 2:
+						m.dualvar[b] -= delta
+					}
+				}
+			}
+
+			switch deltatype {
+			case 1:
+				break substage // optimum reached
+			case 2:
+				// the edge becomes tight: scan it from its S-vertex
+				m.allowedge[deltaedge] = true
+				i, j := m.edges[deltaedge].i, m.edges[deltaedge].j
+				if m.label[m.inblossom[i]] == 0 {
+					i = j
+				}
+				m.queue = append(m.queue, i)
+			case 3:
+				m.allowedge[deltaedge] = true
+				m.queue = append(m.queue, m.edges[deltaedge].i)
+			case 4:
+				m.expandBlossom(deltablossom, false)
+			}
+		}
+
+		if !augmented {
+			break
+		}
+
+		// expand S-blossoms with a zero dual variable
+		for b := n; b < 2*n; b++ {
+			if m.blossomparent[b] == -1 && m.blossombase[b] >= 0 && m.label[b] == 1 && m.dualvar[b] == 0 {
+				m.expandBlossom(b, true)
+			}
+		}
+	}
+
+	mate := make([]int, n)
+	for v := range mate {
+		mate[v] = -1
+		if m.mate[v] >= 0 {
+			mate[v] = m.endpoint[m.mate[v]]
+		}
+	}
+	return mate
+}
+
+// minVertexDual returns the minimum dual variable of vertices.
+func (m *weightedBlossom) minVertexDual() float64 {
+	min := m.dualvar[0]
+	for _, d := range m.dualvar[1:m.n] {
+		if d < min {
+			min = d
+		}
+	}
+	return min
+}
+
+// at returns s[i], where negative indices count from the end of s.
+func at(s []int, i int) int {
+	if i < 0 {
+		i += len(s)
+	}
+	return s[i]
+}
+
+// indexOf returns the position of x in s, -1 if not found.
+func indexOf(s []int, x int) int {
+	for i, y := range s {
+		if y == x {
+			return i
+		}
+	}
+	return -1
+}
+
+// reverseInts reverses s in place.
+func reverseInts(s []int) {
+	last := len(s) - 1
+	for i := 0; i < len(s)/2; i++ {
+		s[i], s[last-i] = s[last-i], s[i]
+	}
+}
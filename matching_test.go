@@ -0,0 +1,113 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// bestMatching exhaustively looks for the best matching of the edges from k on,
+// given already matched vertices.
+// It returns the cardinality and the weight of the matching.
+// If maxCardinality is true, the cardinality is maximised first.
+func bestMatching(edges []weightedEdge, k int, matched []bool, maxCardinality bool) (int, float64) {
+	if k == len(edges) {
+		return 0, 0
+	}
+
+	// edge k is not matched
+	bestCard, bestWeight := bestMatching(edges, k+1, matched, maxCardinality)
+
+	// edge k is matched
+	e := edges[k]
+	if !matched[e.i] && !matched[e.j] {
+		matched[e.i], matched[e.j] = true, true
+		card, weight := bestMatching(edges, k+1, matched, maxCardinality)
+		matched[e.i], matched[e.j] = false, false
+		card, weight = card+1, weight+e.w
+
+		if maxCardinality && card != bestCard {
+			if card > bestCard {
+				bestCard, bestWeight = card, weight
+			}
+		} else if weight > bestWeight {
+			bestCard, bestWeight = card, weight
+		}
+	}
+	return bestCard, bestWeight
+}
+
+// TestMaximumWeightMatching compares the matchings found on random undirected graphs
+// with an exhaustive search.
+func TestMaximumWeightMatching(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 2000; trial++ {
+		n := 1 + rnd.Intn(9)
+		g := randomAdjacency(rnd, n, rnd.Float64(), 20)
+		// make the graph undirected
+		var edges []weightedEdge
+		for i, v := range g.vertices {
+			for j, w := range g.vertices[:i] {
+				if _, ok := g.weight[[2]string{v, w}]; ok {
+					if _, ok := g.weight[[2]string{w, v}]; !ok {
+						g.next[w] = append(g.next[w], v)
+					}
+					g.weight[[2]string{w, v}] = g.weight[[2]string{v, w}]
+				} else if c, ok := g.weight[[2]string{w, v}]; ok {
+					g.next[v] = append(g.next[v], w)
+					g.weight[[2]string{v, w}] = c
+				} else {
+					continue
+				}
+				edges = append(edges, weightedEdge{i, j, g.weight[[2]string{v, w}]})
+			}
+		}
+
+		for _, maxCardinality := range []bool{false, true} {
+			matching := MaximumWeightMatching(g, maxCardinality)
+
+			// check the matching and compute its weight
+			card := 0
+			var weight float64
+			for v, w := range matching {
+				if matching[w] != v {
+					t.Fatalf("trial %d: %s is matched with %s but %s is matched with %s", trial, v, w, w, matching[w])
+				}
+				c, ok := g.weight[[2]string{v, w}]
+				if !ok {
+					t.Fatalf("trial %d: %s-%s is not an edge", trial, v, w)
+				}
+				if v < w {
+					card++
+					weight += c
+				}
+			}
+
+			expectedCard, expectedWeight := bestMatching(edges, 0, make([]bool, n), maxCardinality)
+			if weight != expectedWeight || (maxCardinality && card != expectedCard) {
+				t.Errorf("trial %d, max cardinality %v: %d edges with weight %v instead of %d with %v",
+					trial, maxCardinality, card, weight, expectedCard, expectedWeight)
+			}
+		}
+
+		// maximum cardinality matching
+		expectedCard, _ := bestMatching(edges, 0, make([]bool, n), true)
+		if card := len(MaximumMatching(g)) / 2; card != expectedCard {
+			t.Errorf("trial %d: maximum matching has %d edges instead of %d", trial, card, expectedCard)
+		}
+	}
+}
+
+// TestMatchingUnlistedVertex checks that edges leading to vertices which are not listed are ignored.
+func TestMatchingUnlistedVertex(t *testing.T) {
+	g := adjacency{
+		vertices: []string{"a", "b"},
+		next:     map[string][]string{"b": []string{"x"}},
+		weight:   map[[2]string]float64{{"b", "x"}: 1},
+	}
+	if matching := MaximumMatching(g); len(matching) != 0 {
+		t.Errorf("unexpected matching %v", matching)
+	}
+	if matching := MaximumWeightMatching(g, false); len(matching) != 0 {
+		t.Errorf("unexpected weighted matching %v", matching)
+	}
+}
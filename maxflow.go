@@ -0,0 +1,299 @@
+package graph
+
+import "math"
+
+// Flow is a flow from a source vertex to a sink vertex in a graph with capacities.
+type Flow struct {
+	value     float64
+	edgeFlow  map[[2]string]float64 // flow along the edges of the graph
+	reachable map[string]bool       // vertices reachable from the source in the residual graph
+}
+
+// Value returns the total amount of flow going from the source to the sink.
+func (f *Flow) Value() float64 { return f.value }
+
+// EdgeFlow returns the amount of flow going through the edge.
+func (f *Flow) EdgeFlow(from, to string) float64 { return f.edgeFlow[[2]string{from, to}] }
+
+// Reachable returns true if v is reachable from the source in the residual graph,
+// i.e. if more flow could be sent from the source to v.
+// When the flow is maximum, the sink is not reachable
+// and reachable vertices are the source side of a minimum cut.
+func (f *Flow) Reachable(v string) bool { return f.reachable[v] }
+
+// EdmondsKarp computes a maximum flow from the source to the sink.
+// Capacities must be non-negative and finite.
+//
+// It repeatedly augments the flow along a shortest path of the residual graph,
+// found with BreadthFirstSearchEdges.
+// It runs in O(VE^2) time.
+//
+// The slice returned by calls to NextVertices is never modified.
+// So there is no risk of accidentally modifying g.
+func EdmondsKarp(g CapacityForward, source, sink string) *Flow {
+	net := newNetwork(g, source)
+	if _, found := net.index[sink]; !found || sink == source {
+		return net.flow(source)
+	}
+
+	for {
+		// look for an augmenting path
+		residual := residualEdges{net: net, arc: make(map[[2]string]int)}
+		vis := &augmentingPath{pred: make(map[string]string)}
+		BreadthFirstSearchEdges(residual, vis, source, sink)
+		if _, found := vis.pred[sink]; !found {
+			break // flow is maximum
+		}
+
+		// walk back the path and find the bottleneck
+		var path []int
+		bottleneck := math.Inf(1)
+		for v := sink; v != source; v = vis.pred[v] {
+			a := residual.arc[[2]string{vis.pred[v], v}]
+			path = append(path, a)
+			bottleneck = math.Min(bottleneck, net.residual(a))
+		}
+
+		// push flow along the path
+		for _, a := range path {
+			net.push(a, bottleneck)
+		}
+	}
+
+	return net.flow(source)
+}
+
+// Dinic computes a maximum flow from the source to the sink.
+// Capacities must be non-negative and finite.
+//
+// It repeatedly builds the level graph of the residual graph
+// and saturates it with a blocking flow.
+// It runs in O(V^2E) time and is usually much faster than EdmondsKarp.
+//
+// The slice returned by calls to NextVertices is never modified.
+// So there is no risk of accidentally modifying g.
+func Dinic(g CapacityForward, source, sink string) *Flow {
+	net := newNetwork(g, source)
+	t, found := net.index[sink]
+	if !found || sink == source {
+		return net.flow(source)
+	}
+
+	n := len(net.vertices)
+	level := make([]int, n)
+	next := make([]int, n) // next arc to explore for each vertex
+	queue := make([]int, 0, n)
+	for {
+		// compute levels with a breadth-first search from the source
+		for v := range level {
+			level[v] = -1
+		}
+		level[0] = 0
+		queue = append(queue[:0], 0)
+		for i := 0; i < len(queue); i++ {
+			v := queue[i]
+			for _, a := range net.adj[v] {
+				if w := net.head[a]; level[w] < 0 && net.residual(a) > 0 {
+					level[w] = level[v] + 1
+					queue = append(queue, w)
+				}
+			}
+		}
+		if level[t] < 0 {
+			break // flow is maximum
+		}
+
+		// saturate the level graph:
+		// with no limit, the source explores all its arcs
+		for v := range next {
+			next[v] = 0
+		}
+		net.blockingFlow(0, t, math.Inf(1), level, next)
+	}
+
+	return net.flow(source)
+}
+
+// blockingFlow pushes at most limit from v to t along arcs of the level graph.
+// It returns the amount of flow which has been pushed.
+func (net *network) blockingFlow(v, t int, limit float64, level, next []int) float64 {
+	if v == t {
+		return limit
+	}
+
+	var pushed float64
+	for ; next[v] < len(net.adj[v]); next[v]++ {
+		a := net.adj[v][next[v]]
+		w := net.head[a]
+		r := net.residual(a)
+		if r <= 0 || level[w] != level[v]+1 {
+			continue
+		}
+
+		if f := net.blockingFlow(w, t, math.Min(limit-pushed, r), level, next); f > 0 {
+			net.push(a, f)
+			pushed += f
+			if pushed >= limit {
+				return pushed // arc may not be saturated: do not skip it
+			}
+		}
+	}
+	return pushed
+}
+
+// network is the residual network of a graph with capacities.
+// Vertices and arcs are indexed.
+// Each edge of the graph is an arc a whose reverse arc is a^1.
+type network struct {
+	vertices []string       // name of the vertices, the source is vertex 0
+	index    map[string]int // index of the vertices
+	adj      [][]int        // arcs leaving each vertex
+	head     []int          // target vertex of each arc
+	capacity []float64      // capacity of each arc, zero for reverse arcs
+	flowArc  []float64      // flow along each arc, flowArc[a^1] == -flowArc[a]
+
+	edges map[[2]string]int // arc of each edge of the graph
+}
+
+// newNetwork builds the residual network of the subgraph of g reachable from the source.
+// There is no flow yet.
+func newNetwork(g CapacityForward, source string) *network {
+	net := &network{
+		index: make(map[string]int),
+		edges: make(map[[2]string]int),
+	}
+	net.vertex(source)
+	BreadthFirstVisit(g, networkBuilder{net, g}, source)
+	return net
+}
+
+// vertex returns the index of vertex v.
+// v is added to the network if needed.
+func (net *network) vertex(v string) int {
+	i, found := net.index[v]
+	if !found {
+		i = len(net.vertices)
+		net.index[v] = i
+		net.vertices = append(net.vertices, v)
+		net.adj = append(net.adj, nil)
+	}
+	return i
+}
+
+// addEdge adds the edge (from, to) with its capacity.
+// Its arc is returned.
+// Parallel edges are merged: only the first one is added.
+func (net *network) addEdge(from, to string, capacity float64) int {
+	key := [2]string{from, to}
+	if a, found := net.edges[key]; found {
+		return a
+	}
+
+	u, v := net.vertex(from), net.vertex(to)
+	a := len(net.head)
+	net.head = append(net.head, v, u)
+	net.capacity = append(net.capacity, capacity, 0)
+	net.flowArc = append(net.flowArc, 0, 0)
+	net.adj[u] = append(net.adj[u], a)
+	net.adj[v] = append(net.adj[v], a^1)
+	net.edges[key] = a
+	return a
+}
+
+// tail returns the vertex arc a is leaving.
+func (net *network) tail(a int) int { return net.head[a^1] }
+
+// residual returns the residual capacity of arc a.
+func (net *network) residual(a int) float64 { return net.capacity[a] - net.flowArc[a] }
+
+// push pushes f along arc a.
+func (net *network) push(a int, f float64) {
+	net.flowArc[a] += f
+	net.flowArc[a^1] -= f
+}
+
+// flow returns the current flow of the network.
+func (net *network) flow(source string) *Flow {
+	f := &Flow{
+		edgeFlow:  make(map[[2]string]float64),
+		reachable: make(map[string]bool),
+	}
+
+	// the value is the flow leaving the source
+	for _, a := range net.adj[0] {
+		f.value += net.flowArc[a]
+	}
+	for key, a := range net.edges {
+		if net.flowArc[a] > 0 {
+			f.edgeFlow[key] = net.flowArc[a]
+		}
+	}
+
+	// look for vertices reachable in the residual graph
+	f.reachable[source] = true
+	queue := []int{0}
+	for i := 0; i < len(queue); i++ {
+		for _, a := range net.adj[queue[i]] {
+			if w := net.vertices[net.head[a]]; !f.reachable[w] && net.residual(a) > 0 {
+				f.reachable[w] = true
+				queue = append(queue, net.head[a])
+			}
+		}
+	}
+
+	return f
+}
+
+// networkBuilder is a BfsVisitor adding the examined edges to a network.
+type networkBuilder struct {
+	net *network
+	g   CapacityForward
+}
+
+func (b networkBuilder) DiscoverVertex(string)      {}
+func (b networkBuilder) ExamineVertex(string)       {}
+func (b networkBuilder) TreeEdge(string, string)    {}
+func (b networkBuilder) NonTreeEdge(string, string) {}
+func (b networkBuilder) GrayTarget(string, string)  {}
+func (b networkBuilder) BlackTarget(string, string) {}
+func (b networkBuilder) FinishVertex(string)        {}
+
+func (b networkBuilder) ExamineEdge(from, to string) {
+	b.net.addEdge(from, to, b.g.Capacity(from, to))
+}
+
+// residualEdges is the EdgeForward residual graph of a network:
+// only arcs with a positive residual capacity are edges.
+// Edges are weighted with their residual capacity.
+type residualEdges struct {
+	net *network
+	arc map[[2]string]int // an arc behind the edges returned between two vertices
+}
+
+func (r residualEdges) OutEdges(v string) []Edge {
+	var edges []Edge
+	for _, a := range r.net.adj[r.net.index[v]] {
+		if c := r.net.residual(a); c > 0 {
+			w := r.net.vertices[r.net.head[a]]
+			edges = append(edges, Edge{From: v, To: w, Weight: c})
+			r.arc[[2]string{v, w}] = a
+		}
+	}
+	return edges
+}
+
+// augmentingPath is a BfsEdgeVisitor recording the breadth-first search tree
+// of a residual graph.
+type augmentingPath struct {
+	pred map[string]string // parent of a vertex in the tree
+}
+
+func (vis *augmentingPath) DiscoverVertex(string) {}
+func (vis *augmentingPath) ExamineVertex(string)  {}
+func (vis *augmentingPath) ExamineEdge(Edge)      {}
+func (vis *augmentingPath) NonTreeEdge(Edge)      {}
+func (vis *augmentingPath) GrayTarget(Edge)       {}
+func (vis *augmentingPath) BlackTarget(Edge)      {}
+func (vis *augmentingPath) FinishVertex(string)   {}
+
+func (vis *augmentingPath) TreeEdge(e Edge) { vis.pred[e.To] = e.From }
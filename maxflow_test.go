@@ -0,0 +1,110 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// maxFlowAlgorithms are the maximum flow functions under test.
+var maxFlowAlgorithms = []struct {
+	name    string
+	maxFlow func(g CapacityForward, source, sink string) *Flow
+}{
+	{"edmonds_karp", EdmondsKarp},
+	{"dinic", Dinic},
+	{"push_relabel", PushRelabel},
+}
+
+// checkMaxFlow checks that f is a valid flow of g from the source to the sink
+// and that it is maximum: its value is equal to the capacity of the cut
+// defined by the reachable vertices.
+func checkMaxFlow(t *testing.T, g adjacency, f *Flow, source, sink string) {
+	t.Helper()
+
+	if f.Reachable(sink) {
+		t.Errorf("sink is reachable in the residual graph")
+	}
+
+	excess := make(map[string]float64)
+	var cut float64
+	for _, v := range g.Vertices() {
+		for _, w := range g.NextVertices(v) {
+			flow := f.EdgeFlow(v, w)
+			if flow < 0 || flow > g.Capacity(v, w) {
+				t.Errorf("flow %v on edge %s-%s exceeds capacity %v", flow, v, w, g.Capacity(v, w))
+			}
+			excess[v] -= flow
+			excess[w] += flow
+			if f.Reachable(v) && !f.Reachable(w) {
+				cut += g.Capacity(v, w)
+			}
+		}
+	}
+
+	for _, v := range g.Vertices() {
+		if v != source && v != sink && excess[v] != 0 {
+			t.Errorf("flow is not conserved at vertex %s: excess is %v", v, excess[v])
+		}
+	}
+	if excess[sink] != f.Value() {
+		t.Errorf("sink receives %v instead of %v", excess[sink], f.Value())
+	}
+	if cut != f.Value() {
+		t.Errorf("flow value %v is not equal to cut capacity %v", f.Value(), cut)
+	}
+}
+
+// TestMaxFlow runs maximum flow algorithms on random graphs.
+func TestMaxFlow(t *testing.T) {
+	for _, alg := range maxFlowAlgorithms {
+		t.Run(
+			alg.name,
+			func(t *testing.T) {
+				rnd := rand.New(rand.NewSource(1))
+				for n := 2; n < 20; n++ {
+					for trial := 0; trial < 10; trial++ {
+						g := randomAdjacency(rnd, n, 0.3, 10)
+						source, sink := g.vertices[0], g.vertices[n-1]
+						checkMaxFlow(t, g, alg.maxFlow(g, source, sink), source, sink)
+					}
+				}
+			},
+		)
+	}
+}
+
+// TestMaxFlowUnreachableSink checks that the flow is zero when the sink cannot be reached.
+func TestMaxFlowUnreachableSink(t *testing.T) {
+	g := adjacency{
+		vertices: []string{"s", "a", "t"},
+		next:     map[string][]string{"s": []string{"a"}, "t": []string{"a"}},
+		weight:   map[[2]string]float64{{"s", "a"}: 1, {"t", "a"}: 1},
+	}
+	for _, alg := range maxFlowAlgorithms {
+		f := alg.maxFlow(g, "s", "t")
+		if f.Value() != 0 {
+			t.Errorf("%s: flow value is %v instead of 0", alg.name, f.Value())
+		}
+		if !f.Reachable("s") || !f.Reachable("a") {
+			t.Errorf("%s: source side should be s and a", alg.name)
+		}
+	}
+}
+
+// BenchmarkMaxFlow compares maximum flow algorithms on a random sparse network.
+func BenchmarkMaxFlow(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	g := randomAdjacency(rnd, 300, 0.05, 100)
+	source, sink := g.vertices[0], g.vertices[len(g.vertices)-1]
+
+	for _, alg := range maxFlowAlgorithms {
+		b.Run(
+			alg.name,
+			func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					alg.maxFlow(g, source, sink)
+				}
+			},
+		)
+	}
+}
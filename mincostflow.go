@@ -0,0 +1,104 @@
+package graph
+
+import "math"
+
+// MinCostFlow sends at most amount units of flow from the source to the sink
+// at minimum cost. Use math.Inf(1) to send a maximum flow.
+// It returns the flow and its total cost.
+// Capacities must be non-negative and finite, and costs must be non-negative.
+//
+// It uses successive shortest paths: the flow is repeatedly augmented along
+// a cheapest path of the residual graph, found with Dijkstra.
+// Vertex potentials keep the reduced costs of residual edges non-negative.
+//
+// The slice returned by calls to NextVertices is never modified.
+// So there is no risk of accidentally modifying g.
+func MinCostFlow(g CostCapacityForward, source, sink string, amount float64) (*Flow, float64) {
+	net := newNetwork(g, source)
+	if _, found := net.index[sink]; !found || sink == source {
+		return net.flow(source), 0
+	}
+
+	// cost of each arc, reverse arcs give back the cost
+	residual := reducedCostEdges{
+		net:       net,
+		cost:      make([]float64, len(net.head)),
+		potential: make([]float64, len(net.vertices)),
+	}
+	for key, a := range net.edges {
+		residual.cost[a] = g.Cost(key[0], key[1])
+		residual.cost[a^1] = -residual.cost[a]
+	}
+
+	var sent, cost float64
+	for sent < amount {
+		// look for the cheapest augmenting path
+		residual.arc = make(map[[2]string]int)
+		tree := newShortestPathTree(source)
+		dijkstra(residual, edgeWeight, tree, source, nil)
+		if _, found := tree.dist[sink]; !found {
+			break // flow is maximum
+		}
+
+		// update potentials so that reduced costs remain non-negative
+		for v, d := range tree.dist {
+			residual.potential[net.index[v]] += d
+		}
+
+		// walk back the path and find the bottleneck
+		var path []int
+		bottleneck := amount - sent
+		for v := sink; v != source; v = tree.pred[v].From {
+			a := residual.arc[[2]string{tree.pred[v].From, v}]
+			path = append(path, a)
+			bottleneck = math.Min(bottleneck, net.residual(a))
+		}
+
+		// push flow along the path
+		for _, a := range path {
+			net.push(a, bottleneck)
+			cost += bottleneck * residual.cost[a]
+		}
+		sent += bottleneck
+	}
+
+	return net.flow(source), cost
+}
+
+// reducedCostEdges is the EdgeForward residual graph of a network
+// weighted with reduced costs.
+type reducedCostEdges struct {
+	net       *network
+	cost      []float64         // cost of the arcs
+	potential []float64         // potential of the vertices
+	arc       map[[2]string]int // cheapest arc behind the edges returned between two vertices
+}
+
+func (r reducedCostEdges) OutEdges(v string) []Edge {
+	var edges []Edge
+	u := r.net.index[v]
+	for _, a := range r.net.adj[u] {
+		if r.net.residual(a) <= 0 {
+			continue
+		}
+		w := r.net.head[a]
+		e := Edge{
+			From:   v,
+			To:     r.net.vertices[w],
+			Weight: r.reducedCost(a),
+		}
+		edges = append(edges, e)
+
+		// Dijkstra relaxes the cheapest of parallel edges
+		key := [2]string{v, e.To}
+		if b, found := r.arc[key]; !found || r.reducedCost(a) < r.reducedCost(b) {
+			r.arc[key] = a
+		}
+	}
+	return edges
+}
+
+// reducedCost returns the reduced cost of arc a.
+func (r reducedCostEdges) reducedCost(a int) float64 {
+	return r.cost[a] + r.potential[r.net.tail(a)] - r.potential[r.net.head[a]]
+}
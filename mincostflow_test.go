@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// TestMinCostFlow runs MinCostFlow on random graphs.
+// It checks that the flow is maximum and that its cost is minimum:
+// there is no negative cycle in the residual graph.
+func TestMinCostFlow(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for n := 2; n < 15; n++ {
+		for trial := 0; trial < 10; trial++ {
+			g := randomAdjacency(rnd, n, 0.3, 10)
+			g.cost = make(map[[2]string]float64)
+			for e := range g.weight {
+				g.cost[e] = float64(rnd.Intn(10))
+			}
+			source, sink := g.vertices[0], g.vertices[n-1]
+
+			f, cost := MinCostFlow(g, source, sink, math.Inf(1))
+			checkMaxFlow(t, g, f, source, sink)
+
+			// check total cost and build the residual graph with the cheapest residual edges
+			residual := adjacency{
+				vertices: g.vertices,
+				next:     make(map[string][]string),
+				weight:   make(map[[2]string]float64),
+			}
+			addResidual := func(v, w string, c float64) {
+				e := [2]string{v, w}
+				if current, found := residual.weight[e]; !found {
+					residual.next[v] = append(residual.next[v], w)
+					residual.weight[e] = c
+				} else if c < current {
+					residual.weight[e] = c
+				}
+			}
+			var expected float64
+			for e, capacity := range g.weight {
+				flow := f.EdgeFlow(e[0], e[1])
+				expected += flow * g.cost[e]
+				if flow < capacity {
+					addResidual(e[0], e[1], g.cost[e])
+				}
+				if flow > 0 {
+					addResidual(e[1], e[0], -g.cost[e])
+				}
+			}
+			if cost != expected {
+				t.Errorf("n=%d trial=%d: cost is %v instead of %v", n, trial, cost, expected)
+			}
+			if FloydWarshall(residual).NegativeCycle() {
+				t.Errorf("n=%d trial=%d: cost is not minimum", n, trial)
+			}
+		}
+	}
+}
+
+// TestMinCostFlowAmount checks that the amount of flow can be limited.
+func TestMinCostFlowAmount(t *testing.T) {
+	// two paths from s to t: a cheap one with capacity 1 and an expensive one
+	g := adjacency{
+		vertices: []string{"s", "a", "b", "t"},
+		next: map[string][]string{
+			"s": []string{"a", "b"},
+			"a": []string{"t"},
+			"b": []string{"t"},
+		},
+		weight: map[[2]string]float64{{"s", "a"}: 1, {"a", "t"}: 1, {"s", "b"}: 5, {"b", "t"}: 5},
+		cost:   map[[2]string]float64{{"s", "a"}: 1, {"a", "t"}: 1, {"s", "b"}: 3, {"b", "t"}: 3},
+	}
+
+	testcases := []struct {
+		amount float64
+		value  float64
+		cost   float64
+	}{
+		{0, 0, 0},
+		{1, 1, 2},
+		{2.5, 2.5, 11},
+		{math.Inf(1), 6, 32},
+	}
+	for _, tc := range testcases {
+		f, cost := MinCostFlow(g, "s", "t", tc.amount)
+		if f.Value() != tc.value || cost != tc.cost {
+			t.Errorf("amount %v: flow %v with cost %v instead of %v with cost %v", tc.amount, f.Value(), cost, tc.value, tc.cost)
+		}
+	}
+}
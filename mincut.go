@@ -0,0 +1,57 @@
+package graph
+
+// Cut is a partition of the vertices of a graph
+// into a source side and a sink side.
+type Cut struct {
+	// Source is the list of vertices on the source side.
+	Source []string
+
+	// Sink is the list of vertices on the sink side.
+	Sink []string
+
+	// Edges is the list of edges going from the source side to the sink side.
+	// Their weight is their capacity.
+	Edges []Edge
+
+	// Capacity is the sum of the capacities of the crossing edges.
+	Capacity float64
+}
+
+// MinCut computes a minimum cut separating the source from the sink,
+// i.e. a set of edges of minimum total capacity
+// whose removal disconnects the sink from the source.
+// Capacities must be non-negative and finite.
+//
+// The cut is derived from a maximum flow computed with Dinic:
+// the source side is made of the vertices reachable from the source in the residual graph.
+// Its capacity is equal to the value of the maximum flow.
+//
+// Vertices and edges are listed in the order of Vertices and NextVertices.
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func MinCut(g VertexListCapacityForward, source, sink string) *Cut {
+	f := Dinic(g, source, sink)
+
+	cut := &Cut{}
+	for _, v := range g.Vertices() {
+		if !f.Reachable(v) {
+			cut.Sink = append(cut.Sink, v)
+			continue
+		}
+
+		cut.Source = append(cut.Source, v)
+		crossed := make(map[string]bool) // parallel edges are merged
+		for _, w := range g.NextVertices(v) {
+			if f.Reachable(w) || crossed[w] {
+				continue
+			}
+			crossed[w] = true
+
+			c := g.Capacity(v, w)
+			cut.Edges = append(cut.Edges, Edge{From: v, To: w, Weight: c})
+			cut.Capacity += c
+		}
+	}
+
+	return cut
+}
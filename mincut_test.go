@@ -0,0 +1,79 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// TestMinCut checks minimum cuts of random graphs with parallel edges
+// against the value of the maximum flow.
+func TestMinCut(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for n := 2; n < 15; n++ {
+		for trial := 0; trial < 10; trial++ {
+			g := randomAdjacency(rnd, n, 0.3, 10)
+			// duplicate some edges
+			for _, v := range g.vertices {
+				if len(g.next[v]) != 0 && rnd.Intn(2) == 0 {
+					g.next[v] = append(g.next[v], g.next[v][rnd.Intn(len(g.next[v]))])
+				}
+			}
+			source, sink := g.vertices[0], g.vertices[n-1]
+
+			cut := MinCut(g, source, sink)
+			if value := Dinic(g, source, sink).Value(); cut.Capacity != value {
+				t.Errorf("n=%d, trial %d: cut capacity %v instead of %v", n, trial, cut.Capacity, value)
+			}
+
+			// source and sink sides partition the vertices
+			side := make(map[string]string)
+			for _, v := range cut.Source {
+				side[v] = "source"
+			}
+			for _, v := range cut.Sink {
+				if side[v] != "" {
+					t.Errorf("n=%d, trial %d: %s on both sides", n, trial, v)
+				}
+				side[v] = "sink"
+			}
+			if len(cut.Source)+len(cut.Sink) != n || len(side) != n {
+				t.Errorf("n=%d, trial %d: sides do not partition the vertices", n, trial)
+			}
+			if side[source] != "source" || side[sink] != "sink" {
+				t.Errorf("n=%d, trial %d: source or sink on the wrong side", n, trial)
+			}
+
+			// cut edges are the distinct edges from the source side to the sink side
+			expected := make(map[[2]string]bool)
+			for _, v := range cut.Source {
+				for _, w := range g.next[v] {
+					if side[w] == "sink" {
+						expected[[2]string{v, w}] = true
+					}
+				}
+			}
+			capacity := 0.0
+			found := make(map[[2]string]bool)
+			for _, e := range cut.Edges {
+				key := [2]string{e.From, e.To}
+				if found[key] {
+					t.Errorf("n=%d, trial %d: parallel edge %s -> %s not merged", n, trial, e.From, e.To)
+				}
+				found[key] = true
+				if !expected[key] {
+					t.Errorf("n=%d, trial %d: edge %s -> %s does not cross the cut", n, trial, e.From, e.To)
+				}
+				if e.Weight != g.Capacity(e.From, e.To) {
+					t.Errorf("n=%d, trial %d: wrong capacity of %s -> %s", n, trial, e.From, e.To)
+				}
+				capacity += e.Weight
+			}
+			if len(found) != len(expected) {
+				t.Errorf("n=%d, trial %d: %d cut edges instead of %d", n, trial, len(found), len(expected))
+			}
+			if capacity != cut.Capacity {
+				t.Errorf("n=%d, trial %d: edges sum to %v instead of %v", n, trial, capacity, cut.Capacity)
+			}
+		}
+	}
+}
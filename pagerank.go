@@ -0,0 +1,176 @@
+package graph
+
+import "math"
+
+// Centrality is the result of an iterative centrality computation.
+type Centrality struct {
+	// Score is the score of each vertex.
+	Score map[string]float64
+
+	// Iterations is the number of iterations performed.
+	Iterations int
+
+	// Converged is true if the tolerance was reached before the maximum number of iterations.
+	Converged bool
+
+	// Delta is the L1 distance between the scores of the last two iterations.
+	Delta float64
+}
+
+// PageRankOptions configures PageRank computations.
+// Zero values select the defaults.
+type PageRankOptions struct {
+	// Damping is the probability to follow an edge rather than teleport.
+	// It defaults to 0.85.
+	Damping float64
+
+	// Tolerance stops the iterations when the L1 distance between successive scores is below it.
+	// It defaults to 1e-9.
+	Tolerance float64
+
+	// MaxIterations is the maximum number of iterations.
+	// It defaults to 100.
+	MaxIterations int
+
+	// Personalization is the teleport distribution, normalised to sum to one.
+	// Vertices not in the map have a zero weight.
+	// It defaults to the uniform distribution.
+	Personalization map[string]float64
+
+	// Dangling is the distribution followed from vertices without out edges, normalised to sum to one.
+	// It defaults to the teleport distribution.
+	Dangling map[string]float64
+}
+
+// PageRank computes the PageRank of the vertices of a directed graph:
+// the stationary distribution of a random walk following out edges uniformly at random
+// and teleporting with probability 1 - Damping.
+// Scores sum to one.
+//
+// It uses power iteration, each iteration running in O(V+E) time.
+// Edges leading to vertices which are not listed by Vertices are ignored.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func PageRank(g VertexListForward, opts PageRankOptions) *Centrality {
+	return pageRank(g, func(string, string) float64 { return 1 }, opts)
+}
+
+// WeightedPageRank is similar to PageRank
+// except that out edges are followed with a probability proportional to their weight.
+// Edges with a non-positive weight are ignored.
+func WeightedPageRank(g VertexListWeightForward, opts PageRankOptions) *Centrality {
+	return pageRank(g, g.Weight, opts)
+}
+
+func pageRank(g VertexListForward, weight func(from, to string) float64, opts PageRankOptions) *Centrality {
+	if opts.Damping == 0 {
+		opts.Damping = 0.85
+	}
+	if opts.Tolerance == 0 {
+		opts.Tolerance = 1e-9
+	}
+	if opts.MaxIterations == 0 {
+		opts.MaxIterations = 100
+	}
+
+	vertices := g.Vertices()
+	n := len(vertices)
+	result := &Centrality{Score: make(map[string]float64, n)}
+	if n == 0 {
+		result.Converged = true
+		return result
+	}
+	index := make(map[string]int, n)
+	for i, v := range vertices {
+		index[v] = i
+	}
+
+	// out edges with transition probabilities
+	type arc struct {
+		to int
+		p  float64
+	}
+	out := make([][]arc, n)
+	for i, v := range vertices {
+		total := 0.0
+		for _, w := range g.NextVertices(v) {
+			j, found := index[w]
+			if x := weight(v, w); found && x > 0 {
+				out[i] = append(out[i], arc{to: j, p: x})
+				total += x
+			}
+		}
+		for k := range out[i] {
+			out[i][k].p /= total
+		}
+	}
+
+	teleport := distribution(vertices, index, opts.Personalization)
+	dangling := teleport
+	if opts.Dangling != nil {
+		dangling = distribution(vertices, index, opts.Dangling)
+	}
+
+	score := make([]float64, n)
+	for i := range score {
+		score[i] = 1 / float64(n)
+	}
+	next := make([]float64, n)
+	for result.Iterations < opts.MaxIterations {
+		result.Iterations++
+
+		// mass of dangling vertices
+		lost := 0.0
+		for i, s := range score {
+			if len(out[i]) == 0 {
+				lost += s
+			}
+		}
+		for i := range next {
+			next[i] = opts.Damping*lost*dangling[i] + (1-opts.Damping)*teleport[i]
+		}
+		for i, s := range score {
+			for _, a := range out[i] {
+				next[a.to] += opts.Damping * s * a.p
+			}
+		}
+
+		result.Delta = 0
+		for i := range score {
+			result.Delta += math.Abs(next[i] - score[i])
+		}
+		score, next = next, score
+		if result.Delta < opts.Tolerance {
+			result.Converged = true
+			break
+		}
+	}
+
+	for i, v := range vertices {
+		result.Score[v] = score[i]
+	}
+	return result
+}
+
+// distribution returns the weights of the vertices normalised to sum to one.
+// Vertices which are not listed are ignored.
+// It is uniform if there is no positive weight.
+func distribution(vertices []string, index map[string]int, weights map[string]float64) []float64 {
+	d := make([]float64, len(vertices))
+	total := 0.0
+	for v, x := range weights {
+		if i, found := index[v]; found && x > 0 {
+			d[i] = x
+			total += x
+		}
+	}
+	for i := range d {
+		if total > 0 {
+			d[i] /= total
+		} else {
+			d[i] = 1 / float64(len(d))
+		}
+	}
+	return d
+}
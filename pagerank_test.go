@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// TestPageRank checks on random weighted graphs that the scores are a fixed point
+// of the PageRank equations.
+func TestPageRank(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 100; trial++ {
+		g := randomAdjacency(rnd, 1+rnd.Intn(10), 0.3, 5)
+		opts := PageRankOptions{
+			Damping:       0.5 + 0.4*rnd.Float64(),
+			Tolerance:     1e-12,
+			MaxIterations: 1000,
+		}
+		if rnd.Intn(2) == 0 {
+			opts.Personalization = map[string]float64{g.vertices[0]: 1, g.vertices[len(g.vertices)-1]: 2}
+		}
+		if rnd.Intn(2) == 0 {
+			opts.Dangling = map[string]float64{g.vertices[0]: 1}
+		}
+
+		result := WeightedPageRank(g, opts)
+		if !result.Converged {
+			t.Fatalf("trial %d: no convergence after %d iterations", trial, result.Iterations)
+		}
+
+		teleport := make(map[string]float64)
+		dangling := make(map[string]float64)
+		for _, v := range g.vertices {
+			teleport[v] = 1 / float64(len(g.vertices))
+		}
+		if opts.Personalization != nil {
+			teleport = map[string]float64{}
+			sum := 0.0
+			for _, x := range opts.Personalization {
+				sum += x
+			}
+			for v, x := range opts.Personalization {
+				teleport[v] = x / sum
+			}
+		}
+		for v, x := range teleport {
+			dangling[v] = x
+		}
+		if opts.Dangling != nil {
+			dangling = map[string]float64{g.vertices[0]: 1}
+		}
+
+		expected := make(map[string]float64)
+		total := 0.0
+		for _, v := range g.vertices {
+			total += result.Score[v]
+			expected[v] += (1 - opts.Damping) * teleport[v]
+			out := 0.0
+			for _, w := range g.next[v] {
+				out += g.Weight(v, w)
+			}
+			if out == 0 {
+				for w, x := range dangling {
+					expected[w] += opts.Damping * result.Score[v] * x
+				}
+				continue
+			}
+			for _, w := range g.next[v] {
+				expected[w] += opts.Damping * result.Score[v] * g.Weight(v, w) / out
+			}
+		}
+		if math.Abs(total-1) > 1e-9 {
+			t.Errorf("trial %d: scores sum to %v", trial, total)
+		}
+		for _, v := range g.vertices {
+			if math.Abs(result.Score[v]-expected[v]) > 1e-9 {
+				t.Errorf("trial %d: score of %s is %v instead of %v", trial, v, result.Score[v], expected[v])
+			}
+		}
+	}
+}
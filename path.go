@@ -0,0 +1,10 @@
+package graph
+
+// Path is a path in a graph together with its total weight.
+type Path struct {
+	// Vertices are the vertices of the path, from the first to the last one.
+	Vertices []string
+
+	// Weight is the sum of the weights of the edges of the path.
+	Weight float64
+}
@@ -0,0 +1,218 @@
+package graph
+
+// PushRelabel computes a maximum flow from the source to the sink.
+// Capacities must be non-negative and finite.
+//
+// It is the highest-label variant of the push-relabel algorithm,
+// with the gap and global relabelling heuristics.
+// It runs in O(V^2 sqrt(E)) time and is usually the fastest choice for large networks.
+//
+// The slice returned by calls to NextVertices is never modified.
+// So there is no risk of accidentally modifying g.
+func PushRelabel(g CapacityForward, source, sink string) *Flow {
+	net := newNetwork(g, source)
+	t, found := net.index[sink]
+	if !found || sink == source {
+		return net.flow(source)
+	}
+
+	newPushRelabel(net, t).run()
+	return net.flow(source)
+}
+
+// pushRelabel holds the state of the push-relabel algorithm.
+// The source is vertex 0.
+type pushRelabel struct {
+	net *network
+	n   int // number of vertices
+	t   int // sink
+
+	height []int     // height of the vertices
+	excess []float64 // excess of the vertices
+	next   []int     // next arc to examine for each vertex
+
+	active   [][]int // active vertices by height, entries may be stale
+	count    []int   // number of vertices by height
+	highest  int     // highest height of active vertices
+	relabels int     // number of relabels since the last global relabel
+}
+
+func newPushRelabel(net *network, t int) *pushRelabel {
+	n := len(net.vertices)
+	return &pushRelabel{
+		net:    net,
+		n:      n,
+		t:      t,
+		height: make([]int, n),
+		excess: make([]float64, n),
+		next:   make([]int, n),
+		active: make([][]int, 2*n+1),
+		count:  make([]int, 2*n+1),
+	}
+}
+
+// run computes a maximum flow.
+func (pr *pushRelabel) run() {
+	// saturate arcs leaving the source
+	for _, a := range pr.net.adj[0] {
+		if c := pr.net.residual(a); c > 0 {
+			pr.net.push(a, c)
+			pr.excess[pr.net.head[a]] += c
+			pr.excess[0] -= c
+		}
+	}
+	pr.globalRelabel()
+
+	// discharge active vertices, highest first
+	for pr.highest >= 0 {
+		bucket := pr.active[pr.highest]
+		if len(bucket) == 0 {
+			pr.highest--
+			continue
+		}
+		v := bucket[len(bucket)-1]
+		pr.active[pr.highest] = bucket[:len(bucket)-1]
+
+		// skip stale entries
+		if pr.height[v] != pr.highest || pr.excess[v] <= 0 {
+			continue
+		}
+		pr.discharge(v)
+	}
+}
+
+// activate adds v to the active vertices.
+func (pr *pushRelabel) activate(v int) {
+	if v == 0 || v == pr.t {
+		return
+	}
+	h := pr.height[v]
+	pr.active[h] = append(pr.active[h], v)
+	if h > pr.highest {
+		pr.highest = h
+	}
+}
+
+// discharge pushes the excess of v to its neighbours,
+// relabelling it when needed.
+func (pr *pushRelabel) discharge(v int) {
+	net := pr.net
+	for pr.excess[v] > 0 {
+		if pr.next[v] == len(net.adj[v]) {
+			pr.relabel(v)
+			if pr.relabels >= pr.n {
+				// v is still active and is reactivated by the global relabel
+				pr.globalRelabel()
+				return
+			}
+			continue
+		}
+
+		a := net.adj[v][pr.next[v]]
+		w := net.head[a]
+		r := net.residual(a)
+		if r <= 0 || pr.height[v] != pr.height[w]+1 {
+			pr.next[v]++
+			continue
+		}
+
+		// push along an admissible arc
+		d := r
+		if pr.excess[v] < d {
+			d = pr.excess[v]
+		}
+		net.push(a, d)
+		pr.excess[v] -= d
+		inactive := pr.excess[w] <= 0
+		pr.excess[w] += d
+		if inactive {
+			pr.activate(w)
+		}
+	}
+}
+
+// relabel lifts v just above its lowest neighbour in the residual graph.
+// If no other vertex has the former height of v,
+// the gap heuristic is applied.
+func (pr *pushRelabel) relabel(v int) {
+	net := pr.net
+	old := pr.height[v]
+	h := 2 * pr.n
+	for _, a := range net.adj[v] {
+		if net.residual(a) > 0 && pr.height[net.head[a]]+1 < h {
+			h = pr.height[net.head[a]] + 1
+		}
+	}
+
+	pr.count[old]--
+	pr.height[v] = h
+	pr.count[h]++
+	pr.next[v] = 0
+	pr.relabels++
+
+	if old < pr.n && pr.count[old] == 0 {
+		pr.gap(old)
+	}
+}
+
+// gap lifts above the source all vertices higher than k:
+// since no vertex has height k, they cannot reach the sink anymore.
+func (pr *pushRelabel) gap(k int) {
+	for u, h := range pr.height {
+		if h <= k || h >= pr.n {
+			continue
+		}
+		pr.count[h]--
+		pr.height[u] = pr.n + 1
+		pr.count[pr.n+1]++
+		pr.next[u] = 0
+		if pr.excess[u] > 0 {
+			pr.activate(u)
+		}
+	}
+}
+
+// globalRelabel sets the heights to the exact distances to the sink in the residual graph,
+// or to n plus the distance to the source for vertices which cannot reach the sink.
+// Active vertices are then listed again.
+func (pr *pushRelabel) globalRelabel() {
+	net := pr.net
+	unlabelled := 2 * pr.n
+	for v := range pr.height {
+		pr.height[v] = unlabelled
+	}
+	pr.height[0] = pr.n // the sink is never reached through the source
+
+	// backward breadth-first searches from the sink and then from the source
+	queue := make([]int, 0, pr.n)
+	for _, root := range []struct{ v, h int }{{pr.t, 0}, {0, pr.n}} {
+		pr.height[root.v] = root.h
+		queue = append(queue[:0], root.v)
+		for i := 0; i < len(queue); i++ {
+			w := queue[i]
+			for _, a := range net.adj[w] {
+				// arc a^1 goes from u to w
+				u := net.head[a]
+				if pr.height[u] == unlabelled && net.residual(a^1) > 0 {
+					pr.height[u] = pr.height[w] + 1
+					queue = append(queue, u)
+				}
+			}
+		}
+	}
+
+	// rebuild counts and active vertices
+	for h := range pr.count {
+		pr.count[h] = 0
+		pr.active[h] = pr.active[h][:0]
+	}
+	pr.highest = -1
+	for v, h := range pr.height {
+		pr.count[h]++
+		pr.next[v] = 0
+		if pr.excess[v] > 0 {
+			pr.activate(v)
+		}
+	}
+	pr.relabels = 0
+}
@@ -0,0 +1,84 @@
+package graph
+
+// StronglyConnectedComponents returns the strongly connected components of a directed graph:
+// two vertices are in the same component if and only if each one is reachable from the other.
+//
+// Components are listed in reverse topological order:
+// no edge leads from a component to a previous one.
+//
+// It is Tarjan's algorithm, running a low-link depth-first visit in linear time.
+//
+// The slices returned by calls to NextVertices and Vertices are never modified.
+// So there is no risk of accidentally modifying g.
+func StronglyConnectedComponents(g VertexListForward) [][]string {
+	vis := &tarjanVisitor{
+		index:   make(map[string]int),
+		low:     make(map[string]int),
+		parent:  make(map[string]string),
+		onStack: make(map[string]bool),
+	}
+	DepthFirstVisit(g, vis)
+	return vis.components
+}
+
+// tarjanVisitor is a DfsVisitor computing strongly connected components.
+type tarjanVisitor struct {
+	time    int               // current discovery time
+	index   map[string]int    // discovery time of vertices
+	low     map[string]int    // lowest discovery time reachable from the subtree
+	parent  map[string]string // parent in the search tree
+	stack   []string          // vertices of the components in progress
+	onStack map[string]bool
+
+	components [][]string
+}
+
+func (vis *tarjanVisitor) InitializeVertex(string)    {}
+func (vis *tarjanVisitor) ExamineEdge(string, string) {}
+
+func (vis *tarjanVisitor) DiscoverVertex(v string) {
+	vis.index[v] = vis.time
+	vis.low[v] = vis.time
+	vis.time++
+	vis.stack = append(vis.stack, v)
+	vis.onStack[v] = true
+}
+
+func (vis *tarjanVisitor) TreeEdge(from, to string) { vis.parent[to] = from }
+
+func (vis *tarjanVisitor) BackEdge(from, to string) { vis.lower(from, to) }
+
+func (vis *tarjanVisitor) ForwardCrossEdge(from, to string) {
+	// vertices of finished components are ignored
+	if vis.onStack[to] {
+		vis.lower(from, to)
+	}
+}
+
+// lower lowers the low-link of from with the discovery time of to.
+func (vis *tarjanVisitor) lower(from, to string) {
+	if vis.index[to] < vis.low[from] {
+		vis.low[from] = vis.index[to]
+	}
+}
+
+func (vis *tarjanVisitor) FinishVertex(v string) {
+	if vis.low[v] == vis.index[v] {
+		// v is the root of a component: pop it
+		i := len(vis.stack) - 1
+		for vis.stack[i] != v {
+			i--
+		}
+		component := make([]string, len(vis.stack)-i)
+		copy(component, vis.stack[i:])
+		for _, w := range component {
+			vis.onStack[w] = false
+		}
+		vis.stack = vis.stack[:i]
+		vis.components = append(vis.components, component)
+	}
+
+	if p, ok := vis.parent[v]; ok && vis.low[v] < vis.low[p] {
+		vis.low[p] = vis.low[v]
+	}
+}
@@ -0,0 +1,48 @@
+package graph
+
+// unionFind is a disjoint-set forest over elements 0 to n-1,
+// with union by size and path compression.
+type unionFind struct {
+	parent []int
+	size   []int
+}
+
+func newUnionFind(n int) *unionFind {
+	uf := &unionFind{
+		parent: make([]int, n),
+		size:   make([]int, n),
+	}
+	for i := range uf.parent {
+		uf.parent[i] = i
+		uf.size[i] = 1
+	}
+	return uf
+}
+
+// find returns the representative of the set of i.
+func (uf *unionFind) find(i int) int {
+	root := i
+	for uf.parent[root] != root {
+		root = uf.parent[root]
+	}
+	// compress the path
+	for uf.parent[i] != root {
+		uf.parent[i], i = root, uf.parent[i]
+	}
+	return root
+}
+
+// union merges the sets of i and j.
+// It returns false if they were already in the same set.
+func (uf *unionFind) union(i, j int) bool {
+	ri, rj := uf.find(i), uf.find(j)
+	if ri == rj {
+		return false
+	}
+	if uf.size[ri] < uf.size[rj] {
+		ri, rj = rj, ri
+	}
+	uf.parent[rj] = ri
+	uf.size[ri] += uf.size[rj]
+	return true
+}
@@ -0,0 +1,35 @@
+package graph
+
+import "testing"
+
+// TestUnionFind merges elements and checks which ones are in the same set.
+func TestUnionFind(t *testing.T) {
+	uf := newUnionFind(6)
+
+	merges := []struct {
+		i, j   int
+		merged bool
+	}{
+		{0, 1, true},
+		{2, 3, true},
+		{1, 0, false},
+		{1, 3, true},
+		{0, 2, false},
+		{4, 4, false},
+	}
+	for _, m := range merges {
+		if merged := uf.union(m.i, m.j); merged != m.merged {
+			t.Errorf("union(%d, %d) returned %v instead of %v", m.i, m.j, merged, m.merged)
+		}
+	}
+
+	// sets are {0, 1, 2, 3}, {4} and {5}
+	sets := []int{0, 0, 0, 0, 4, 5}
+	for i := range sets {
+		for j := range sets {
+			if same := uf.find(i) == uf.find(j); same != (sets[i] == sets[j]) {
+				t.Errorf("%d and %d in the same set: %v", i, j, same)
+			}
+		}
+	}
+}
@@ -1,6 +1,8 @@
 // Package visitor provides visitors to use with graph algorithms.
 package visitor
 
+import "github.com/batiazinga/graph"
+
 // BfsNoOp is a BfsVisitor which does nothing.
 type BfsNoOp struct{}
 
@@ -34,3 +36,36 @@ func (v DijkstraNoOp) EdgeRelaxed(string, string)      {}
 func (v DijkstraNoOp) EdgeNotRelaxed(string, string)   {}
 func (v DijkstraNoOp) ForwardCrossEdge(string, string) {}
 func (v DijkstraNoOp) FinishVertex(string)             {}
+
+// BfsEdgeNoOp is a BfsEdgeVisitor which does nothing.
+type BfsEdgeNoOp struct{}
+
+func (v BfsEdgeNoOp) DiscoverVertex(string)  {}
+func (v BfsEdgeNoOp) ExamineVertex(string)   {}
+func (v BfsEdgeNoOp) ExamineEdge(graph.Edge) {}
+func (v BfsEdgeNoOp) TreeEdge(graph.Edge)    {}
+func (v BfsEdgeNoOp) NonTreeEdge(graph.Edge) {}
+func (v BfsEdgeNoOp) GrayTarget(graph.Edge)  {}
+func (v BfsEdgeNoOp) BlackTarget(graph.Edge) {}
+func (v BfsEdgeNoOp) FinishVertex(string)    {}
+
+// DfsEdgeNoOp is a DfsEdgeVisitor which does nothing.
+type DfsEdgeNoOp struct{}
+
+func (v DfsEdgeNoOp) InitializeVertex(string)     {}
+func (v DfsEdgeNoOp) DiscoverVertex(string)       {}
+func (v DfsEdgeNoOp) ExamineEdge(graph.Edge)      {}
+func (v DfsEdgeNoOp) TreeEdge(graph.Edge)         {}
+func (v DfsEdgeNoOp) BackEdge(graph.Edge)         {}
+func (v DfsEdgeNoOp) ForwardCrossEdge(graph.Edge) {}
+func (v DfsEdgeNoOp) FinishVertex(string)         {}
+
+// DijkstraEdgeNoOp is a DijkstraEdgeVisitor which does nothing.
+type DijkstraEdgeNoOp struct{}
+
+func (v DijkstraEdgeNoOp) DiscoverVertex(string)     {}
+func (v DijkstraEdgeNoOp) ExamineVertex(string)      {}
+func (v DijkstraEdgeNoOp) ExamineEdge(graph.Edge)    {}
+func (v DijkstraEdgeNoOp) EdgeRelaxed(graph.Edge)    {}
+func (v DijkstraEdgeNoOp) EdgeNotRelaxed(graph.Edge) {}
+func (v DijkstraEdgeNoOp) FinishVertex(string)       {}
@@ -0,0 +1,142 @@
+package graph
+
+import "strings"
+
+// KShortestPaths returns the k shortest loopless paths from the source to the target,
+// ordered by increasing weight.
+// It returns less than k paths if there are not enough paths from the source to the target.
+//
+// It uses Yen's algorithm: each new path deviates from a previously found path at a spur vertex,
+// the rest of the path being the shortest path (computed with Dijkstra)
+// which does not go back through the root of the path and does not reuse an already taken deviation.
+// As with Dijkstra, weights must be non-negative.
+//
+// The slice returned by calls to NextVertices is never modified.
+// So there is no risk of accidentally modifying g.
+func KShortestPaths(g WeightForward, source, target string, k int) []Path {
+	if k <= 0 {
+		return nil
+	}
+	edges := WeightForwardEdges(g)
+
+	// first path is the shortest path
+	first, found := shortestPath(edges, source, target)
+	if !found {
+		return nil
+	}
+	paths := []Path{first}
+
+	// candidate paths, and keys of all known paths to avoid duplicates
+	var candidates []Path
+	known := map[string]bool{pathKey(first): true}
+
+	for len(paths) < k {
+		previous := paths[len(paths)-1].Vertices
+
+		// deviate from the previous path at each of its vertices
+		for i := 0; i < len(previous)-1; i++ {
+			spur := previous[i]
+			root := previous[:i+1]
+
+			// the root path must not be visited again
+			// and deviations already taken from this root are forbidden
+			filter := filteredEdges{
+				g:        edges,
+				vertices: make(map[string]bool),
+				edges:    make(map[[2]string]bool),
+			}
+			for _, v := range root[:i] {
+				filter.vertices[v] = true
+			}
+			for _, p := range paths {
+				if len(p.Vertices) > i+1 && equalVertices(p.Vertices[:i+1], root) {
+					filter.edges[[2]string{p.Vertices[i], p.Vertices[i+1]}] = true
+				}
+			}
+
+			spurPath, found := shortestPath(filter, spur, target)
+			if !found {
+				continue
+			}
+
+			// glue root and spur path
+			candidate := Path{
+				Vertices: make([]string, 0, len(root)+len(spurPath.Vertices)-1),
+				Weight:   spurPath.Weight,
+			}
+			candidate.Vertices = append(candidate.Vertices, root...)
+			candidate.Vertices = append(candidate.Vertices, spurPath.Vertices[1:]...)
+			for j := 0; j < i; j++ {
+				candidate.Weight += g.Weight(root[j], root[j+1])
+			}
+
+			key := pathKey(candidate)
+			if !known[key] {
+				known[key] = true
+				candidates = append(candidates, candidate)
+			}
+		}
+
+		if len(candidates) == 0 {
+			break // no more paths
+		}
+
+		// the lightest candidate is the next shortest path
+		best := 0
+		for j, c := range candidates {
+			if c.Weight < candidates[best].Weight {
+				best = j
+			}
+		}
+		paths = append(paths, candidates[best])
+		candidates = append(candidates[:best], candidates[best+1:]...)
+	}
+
+	return paths
+}
+
+// shortestPath returns the shortest path from the source to the target.
+// It returns false if the target is not reachable.
+func shortestPath(g EdgeForward, source, target string) (Path, bool) {
+	tree := newShortestPathTree(source)
+	dijkstra(g, edgeWeight, tree, source, &target)
+	return tree.path(target)
+}
+
+// filteredEdges is an EdgeForward graph hiding some vertices and edges of another graph.
+type filteredEdges struct {
+	g        EdgeForward
+	vertices map[string]bool    // hidden vertices
+	edges    map[[2]string]bool // hidden edges, whatever their label
+}
+
+func (f filteredEdges) OutEdges(v string) []Edge {
+	if f.vertices[v] {
+		return nil
+	}
+
+	var edges []Edge
+	for _, e := range f.g.OutEdges(v) {
+		if f.vertices[e.To] || f.edges[[2]string{e.From, e.To}] {
+			continue
+		}
+		edges = append(edges, e)
+	}
+	return edges
+}
+
+// pathKey returns a string identifying the vertices of a path.
+func pathKey(p Path) string { return strings.Join(p.Vertices, "\x00") }
+
+// equalVertices returns true if both lists of vertices are equal.
+func equalVertices(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
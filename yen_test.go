@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+// simplePathWeights returns the sorted weights of all loopless paths from v to target.
+func simplePathWeights(g adjacency, v, target string, visited map[string]bool, weight float64) []float64 {
+	if v == target {
+		return []float64{weight}
+	}
+
+	var weights []float64
+	visited[v] = true
+	for _, w := range g.NextVertices(v) {
+		if !visited[w] {
+			weights = append(weights, simplePathWeights(g, w, target, visited, weight+g.Weight(v, w))...)
+		}
+	}
+	visited[v] = false
+	return weights
+}
+
+// TestKShortestPaths compares the paths found on random graphs with an exhaustive enumeration.
+func TestKShortestPaths(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for n := 2; n < 8; n++ {
+		for trial := 0; trial < 10; trial++ {
+			// random digraph with integer weights
+			g := adjacency{
+				next:   make(map[string][]string),
+				weight: make(map[[2]string]float64),
+			}
+			for i := 0; i < n; i++ {
+				g.vertices = append(g.vertices, strconv.Itoa(i))
+			}
+			for _, v := range g.vertices {
+				for _, w := range g.vertices {
+					if v != w && rnd.Intn(2) == 0 {
+						g.next[v] = append(g.next[v], w)
+						g.weight[[2]string{v, w}] = float64(rnd.Intn(10))
+					}
+				}
+			}
+
+			source, target := g.vertices[0], g.vertices[n-1]
+			expected := simplePathWeights(g, source, target, make(map[string]bool), 0)
+			sort.Float64s(expected)
+
+			paths := KShortestPaths(g, source, target, 10)
+			if len(expected) > 10 {
+				expected = expected[:10]
+			}
+			if len(paths) != len(expected) {
+				t.Fatalf("n=%d trial=%d: %d paths instead of %d", n, trial, len(paths), len(expected))
+			}
+			seen := make(map[string]bool)
+			for i, p := range paths {
+				if p.Weight != expected[i] {
+					t.Errorf("n=%d trial=%d: weight of path %d is %v instead of %v", n, trial, i, p.Weight, expected[i])
+				}
+				if seen[pathKey(p)] {
+					t.Errorf("n=%d trial=%d: path %v found twice", n, trial, p.Vertices)
+				}
+				seen[pathKey(p)] = true
+				if p.Vertices[0] != source || p.Vertices[len(p.Vertices)-1] != target {
+					t.Errorf("n=%d trial=%d: path %v does not link source and target", n, trial, p.Vertices)
+				}
+			}
+		}
+	}
+}